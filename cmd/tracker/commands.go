@@ -0,0 +1,152 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Yandex-Practicum/tracker/internal/daysteps"
+	"github.com/Yandex-Practicum/tracker/internal/history"
+	"github.com/Yandex-Practicum/tracker/internal/profile"
+	"github.com/Yandex-Practicum/tracker/internal/storage"
+)
+
+// reportDateLayout — формат дат в отчётах.
+const reportDateLayout = "02.01.2006"
+
+// runLog сохраняет запись в журнал и выводит её расчет.
+func runLog(configPath string, args []string, out io.Writer) error {
+	if len(args) != 2 {
+		return fmt.Errorf("%w: ожидается log walk|training \"запись\"", errUsage)
+	}
+	kind, data := args[0], args[1]
+
+	p, journal, err := loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	var (
+		info string
+		save func(storage.Store) error
+	)
+
+	// Рассчитываем запись до открытия журнала, чтобы не сохранять некорректные данные
+	switch kind {
+	case "walk":
+		entry, err := daysteps.NewDayEntry(now(), data)
+		if err != nil {
+			return err
+		}
+		if info, err = p.DayActionInfo(data); err != nil {
+			return err
+		}
+		save = func(s storage.Store) error { return s.SaveDayPackage(entry) }
+	case "training":
+		entry, err := p.NewTrainingEntry(now(), data)
+		if err != nil {
+			return err
+		}
+		if info, err = p.TrainingInfo(data); err != nil {
+			return err
+		}
+		save = func(s storage.Store) error { return s.SaveTraining(entry) }
+	default:
+		return fmt.Errorf("%w: неизвестный тип записи %q, ожидается walk или training", errUsage, kind)
+	}
+
+	store, err := storage.OpenFile(journal)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	if err := save(store); err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprint(out, info)
+	return err
+}
+
+// runReport выводит отчёт за сегодня или за текущую неделю.
+func runReport(configPath string, args []string, out io.Writer) error {
+	if len(args) != 1 {
+		return fmt.Errorf("%w: ожидается report today|week", errUsage)
+	}
+
+	p, journal, err := loadConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	h, err := loadHistory(p, journal)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "today":
+		report := h.ReportDay(now())
+		fmt.Fprintf(out, "Отчёт за %s.\n", report.Start.Format(reportDateLayout))
+		writeTotals(out, p, report)
+	case "week":
+		report := h.ReportWeek(now())
+		fmt.Fprintf(out, "Отчёт за неделю %s — %s.\n",
+			report.Start.Format(reportDateLayout), report.End.AddDate(0, 0, -1).Format(reportDateLayout))
+		writeTotals(out, p, report)
+
+		if change, ok := report.StepsChange(); ok {
+			fmt.Fprintf(out, "Шаги по сравнению с прошлой неделей: %+.1f%%.\n", change)
+		}
+		if report.MostActiveSteps > 0 {
+			fmt.Fprintf(out, "Самый активный день: %s, шагов: %d.\n",
+				report.MostActiveDay.Format(reportDateLayout), report.MostActiveSteps)
+		}
+	default:
+		return fmt.Errorf("%w: неизвестный период %q, ожидается today или week", errUsage, args[0])
+	}
+
+	return nil
+}
+
+// loadHistory читает журнал и заполняет им историю пользователя.
+// Дневная активность пересчитывается по текущему профилю, тренировки хранятся уже рассчитанными.
+func loadHistory(p profile.Profile, journal string) (*history.History, error) {
+	store, err := storage.OpenFile(journal)
+	if err != nil {
+		return nil, err
+	}
+	defer store.Close()
+
+	// Журнал небольшой и целиком хранится в памяти, поэтому читаем все записи
+	records, err := store.ListByDate(time.Time{}, time.Date(9999, time.January, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := history.New(p.WeightKg(), p.HeightMeters())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range records.Trainings {
+		h.AddTrainingEntry(e)
+	}
+	for _, d := range records.Days {
+		summary, err := p.CalculateDay(d.Steps, d.Duration)
+		if err != nil {
+			return nil, fmt.Errorf("запись за %s: %w", d.Date.Format(reportDateLayout), err)
+		}
+		h.AddDaySummary(d.Date, summary)
+	}
+
+	return h, nil
+}
+
+func writeTotals(out io.Writer, p profile.Profile, report history.Report) {
+	fmt.Fprintf(out, "Количество шагов: %d.\n", report.Steps)
+	fmt.Fprintf(out, "Дистанция составила %.2f %s.\n", p.Units.FromKm(report.Distance), p.Units.DistanceLabel("ru"))
+	fmt.Fprintf(out, "Вы сожгли %.2f ккал.\n", report.Calories)
+	fmt.Fprintf(out, "Время активности: %s.\n", report.Duration)
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/Yandex-Practicum/tracker/internal/profile"
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+	"github.com/Yandex-Practicum/tracker/internal/units"
+)
+
+// defaultJournal — имя файла журнала, если он не указан в конфигурации.
+const defaultJournal = "journal.json"
+
+// config — файл конфигурации с параметрами пользователя, например
+//
+//	{"weight": 75, "height": 1.75, "age": 30, "sex": "male", "units": "metric", "journal": "journal.json"}
+//
+// Относительный путь к журналу отсчитывается от каталога файла конфигурации.
+type config struct {
+	Weight     float64      `json:"weight"`
+	Height     float64      `json:"height"`
+	Age        int          `json:"age"`
+	Sex        string       `json:"sex"`
+	StepLength float64      `json:"step_length"`
+	Units      units.System `json:"units"`
+	Journal    string       `json:"journal"`
+}
+
+// defaultConfigPath возвращает путь к конфигурации в пользовательском каталоге настроек.
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "tracker.json"
+	}
+	return filepath.Join(dir, "tracker", "config.json")
+}
+
+// loadConfig читает конфигурацию и возвращает профиль пользователя и путь к журналу.
+func loadConfig(path string) (profile.Profile, string, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return profile.Profile{}, "", fmt.Errorf("файл конфигурации %s не найден, укажите его флагом -config", path)
+	}
+	if err != nil {
+		return profile.Profile{}, "", fmt.Errorf("не удалось прочитать конфигурацию: %w", err)
+	}
+
+	var cfg config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return profile.Profile{}, "", fmt.Errorf("неверный формат конфигурации %s: %w", path, err)
+	}
+
+	sex, err := spentcalories.ParseSex(cfg.Sex)
+	if err != nil {
+		return profile.Profile{}, "", err
+	}
+
+	p := profile.Profile{
+		Weight:     cfg.Weight,
+		Height:     cfg.Height,
+		Age:        cfg.Age,
+		Sex:        sex,
+		StepLength: cfg.StepLength,
+		Units:      cfg.Units,
+	}
+	if err := p.Validate(); err != nil {
+		return profile.Profile{}, "", fmt.Errorf("неверные параметры в конфигурации: %w", err)
+	}
+
+	journal := cfg.Journal
+	if journal == "" {
+		journal = defaultJournal
+	}
+	if !filepath.IsAbs(journal) {
+		journal = filepath.Join(filepath.Dir(path), journal)
+	}
+
+	return p, journal, nil
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/Yandex-Practicum/tracker/internal/daysteps"
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+// runDemo выводит расчеты для набора примеров, в том числе некорректных.
+func runDemo(out io.Writer) {
+	weight := 84.6
+	height := 1.87
+
+	// дневная активность
+	input := []string{
+		"678,0h50m",
+		"792,1h14m",
+		"1078,1h30m",
+		"7830,2h40m",
+		",3456",
+		"12:40:00, 3456",
+		"something is wrong",
+	}
+
+	fmt.Fprintln(out, "Активность в течение дня")
+
+	var dayActionsLog []string
+
+	for _, v := range input {
+		// Как и в исходной версии, некорректная запись выводится пустой строкой
+		dayActionsInfo, err := daysteps.DayActionInfoErr(v, weight, height)
+		if err != nil {
+			log.Printf("не получилось получить информацию о дневной активности: %v", err)
+		}
+		dayActionsLog = append(dayActionsLog, dayActionsInfo)
+	}
+
+	for _, v := range dayActionsLog {
+		fmt.Fprintln(out, v)
+	}
+
+	// тренировки
+	trainings := []string{
+		"3456,Ходьба,3h00m",
+		"something is wrong",
+		"678,Бег,0h5m",
+		"1078,Бег,0h10m",
+		",3456 Ходьба",
+		"7892,Ходьба,3h10m",
+		"15392,Бег,0h45m",
+	}
+
+	var trainingLog []string
+
+	for _, v := range trainings {
+		trainingInfo, err := spentcalories.TrainingInfo(v, weight, height)
+		if err != nil {
+			log.Printf("не получилось получить информацию о тренировке: %v", err)
+			continue
+		}
+		trainingLog = append(trainingLog, trainingInfo)
+	}
+
+	fmt.Fprintln(out, "Журнал тренировок")
+
+	for _, v := range trainingLog {
+		fmt.Fprintln(out, v)
+	}
+}
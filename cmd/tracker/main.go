@@ -1,69 +1,73 @@
+// Команда tracker записывает дневную активность и тренировки в журнал
+// и выводит отчёты за день и неделю.
+//
+// Использование:
+//
+//	tracker [-config путь] log walk "678,55m"
+//	tracker [-config путь] log training "3456,Бег,0.75h"
+//	tracker [-config путь] report today
+//	tracker [-config путь] report week
+//	tracker demo
+//
+// Вес, рост и другие параметры пользователя читаются из файла конфигурации,
+// по умолчанию из каталога пользовательских настроек.
 package main
 
 import (
+	"errors"
+	"flag"
 	"fmt"
-	"log"
-
-	"github.com/Yandex-Practicum/tracker/internal/daysteps"
-	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+	"io"
+	"os"
+	"time"
 )
 
-func main() {
-	weight := 84.6
-	height := 1.87
-
-	// дневная активность
-	input := []string{
-		"678,0h50m",
-		"792,1h14m",
-		"1078,1h30m",
-		"7830,2h40m",
-		",3456",
-		"12:40:00, 3456",
-		"something is wrong",
-	}
-
-	fmt.Println("Активность в течение дня")
+const usage = `Использование:
+  tracker [-config путь] log walk "шаги,длительность"
+  tracker [-config путь] log training "шаги,активность,длительность"
+  tracker [-config путь] report today|week
+  tracker demo
+`
 
-	var (
-		dayActionsInfo string
-		dayActionsLog  []string
-	)
+// errUsage — ошибка в аргументах командной строки.
+var errUsage = errors.New("неверные аргументы")
 
-	for _, v := range input {
-		dayActionsInfo = daysteps.DayActionInfo(v, weight, height)
-		dayActionsLog = append(dayActionsLog, dayActionsInfo)
-	}
+// now возвращает текущее время; подменяется в тестах.
+var now = time.Now
 
-	for _, v := range dayActionsLog {
-		fmt.Println(v)
+func main() {
+	if err := run(os.Args[1:], os.Stdout, os.Stderr); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		if errors.Is(err, errUsage) {
+			fmt.Fprint(os.Stderr, usage)
+			os.Exit(2)
+		}
+		os.Exit(1)
 	}
+}
 
-	// тренировки
-	trainings := []string{
-		"3456,Ходьба,3h00m",
-		"something is wrong",
-		"678,Бег,0h5m",
-		"1078,Бег,0h10m",
-		",3456 Ходьба",
-		"7892,Ходьба,3h10m",
-		"15392,Бег,0h45m",
+func run(args []string, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("tracker", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	configPath := flags.String("config", defaultConfigPath(), "путь к файлу конфигурации")
+	if err := flags.Parse(args); err != nil {
+		return fmt.Errorf("%w: %w", errUsage, err)
 	}
 
-	var trainingLog []string
-
-	for _, v := range trainings {
-		trainingInfo, err := spentcalories.TrainingInfo(v, weight, height)
-		if err != nil {
-			log.Printf("не получилось получить информацию о тренировке: %v", err)
-			continue
-		}
-		trainingLog = append(trainingLog, trainingInfo)
+	args = flags.Args()
+	if len(args) == 0 {
+		return fmt.Errorf("%w: не указана команда", errUsage)
 	}
 
-	fmt.Println("Журнал тренировок")
-
-	for _, v := range trainingLog {
-		fmt.Println(v)
+	switch args[0] {
+	case "log":
+		return runLog(*configPath, args[1:], stdout)
+	case "report":
+		return runReport(*configPath, args[1:], stdout)
+	case "demo":
+		runDemo(stdout)
+		return nil
+	default:
+		return fmt.Errorf("%w: неизвестная команда %q", errUsage, args[0])
 	}
 }
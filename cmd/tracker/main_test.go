@@ -0,0 +1,124 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type CLITestSuite struct {
+	suite.Suite
+	config string
+}
+
+func TestCLISuite(t *testing.T) {
+	suite.Run(t, new(CLITestSuite))
+}
+
+func (suite *CLITestSuite) SetupTest() {
+	dir := suite.T().TempDir()
+	suite.config = filepath.Join(dir, "config.json")
+	suite.Require().NoError(os.WriteFile(suite.config, []byte(`{"weight": 75, "height": 1.75}`), 0o600))
+
+	// Вторник, 14 января 2025 года
+	now = func() time.Time { return time.Date(2025, time.January, 14, 12, 0, 0, 0, time.UTC) }
+	suite.T().Cleanup(func() { now = time.Now })
+}
+
+func (suite *CLITestSuite) run(args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	err := run(append([]string{"-config", suite.config}, args...), &stdout, &stderr)
+	return stdout.String(), err
+}
+
+func (suite *CLITestSuite) TestLogAndReport() {
+	got, err := suite.run("log", "walk", "6000,1h00m")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Количество шагов: 6000.\nДистанция составила 4.72 км.\nВы сожгли 177.19 ккал.\n", got)
+
+	got, err = suite.run("log", "training", "6000,бег,1h")
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Тип тренировки: бег")
+
+	// Журнал сохраняется рядом с конфигурацией
+	assert.FileExists(suite.T(), filepath.Join(filepath.Dir(suite.config), defaultJournal))
+
+	got, err = suite.run("report", "today")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Отчёт за 14.01.2025.\n"+
+		"Количество шагов: 12000.\n"+
+		"Дистанция составила 9.45 км.\n"+
+		"Вы сожгли 531.56 ккал.\n"+
+		"Время активности: 2h0m0s.\n", got)
+
+	got, err = suite.run("report", "week")
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Отчёт за неделю 13.01.2025 — 19.01.2025.\n")
+	assert.Contains(suite.T(), got, "Самый активный день: 14.01.2025, шагов: 12000.\n")
+	assert.NotContains(suite.T(), got, "по сравнению с прошлой неделей")
+}
+
+func (suite *CLITestSuite) TestLogInvalidRecord() {
+	_, err := suite.run("log", "training", "6000,Плавание,1h00m")
+	assert.ErrorContains(suite.T(), err, "неизвестный тип тренировки")
+
+	// Некорректная запись не попадает в журнал
+	assert.NoFileExists(suite.T(), filepath.Join(filepath.Dir(suite.config), defaultJournal))
+}
+
+func (suite *CLITestSuite) TestUsageErrors() {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{name: "нет команды", args: nil},
+		{name: "неизвестная команда", args: []string{"delete"}},
+		{name: "неизвестный тип записи", args: []string{"log", "run", "6000,1h00m"}},
+		{name: "нет записи", args: []string{"log", "walk"}},
+		{name: "неизвестный период", args: []string{"report", "month"}},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			_, err := suite.run(tt.args...)
+			assert.ErrorIs(suite.T(), err, errUsage)
+		})
+	}
+}
+
+func (suite *CLITestSuite) TestConfigErrors() {
+	dir := suite.T().TempDir()
+
+	var stdout, stderr bytes.Buffer
+	err := run([]string{"-config", filepath.Join(dir, "missing.json"), "report", "today"}, &stdout, &stderr)
+	assert.ErrorContains(suite.T(), err, "не найден")
+
+	invalid := filepath.Join(dir, "invalid.json")
+	assert.NoError(suite.T(), os.WriteFile(invalid, []byte(`{"weight": 0, "height": 1.75}`), 0o600))
+	err = run([]string{"-config", invalid, "report", "today"}, &stdout, &stderr)
+	assert.ErrorContains(suite.T(), err, "вес должен быть больше 0")
+
+	sex := filepath.Join(dir, "sex.json")
+	assert.NoError(suite.T(), os.WriteFile(sex, []byte(`{"weight": 75, "height": 1.75, "sex": "x"}`), 0o600))
+	err = run([]string{"-config", sex, "report", "today"}, &stdout, &stderr)
+	assert.ErrorContains(suite.T(), err, "неизвестный пол")
+}
+
+func (suite *CLITestSuite) TestDemo() {
+	var stdout bytes.Buffer
+	runDemo(&stdout)
+	got := stdout.String()
+
+	// Некорректные дневные записи выводятся пустыми строками, как в исходной версии
+	assert.Equal(suite.T(), 4, strings.Count(got, "Количество шагов:"))
+	assert.Contains(suite.T(), got, "ккал.\n\n\n\n\nЖурнал тренировок\n")
+
+	// Некорректные тренировки пропускаются
+	assert.Equal(suite.T(), 5, strings.Count(got, "Тип тренировки:"))
+}
@@ -0,0 +1,45 @@
+// Команда trackerd-grpc запускает gRPC-сервер сервиса tracker.v1.Tracker
+// из api/tracker/v1/tracker.proto для записи тренировок и дневной активности
+// и получения сводки за день.
+//
+// Записи хранятся в памяти процесса.
+package main
+
+import (
+	"flag"
+	"log/slog"
+	"net"
+	"os"
+	"time"
+
+	"github.com/Yandex-Practicum/tracker/internal/grpcserver"
+)
+
+var logger = slog.Default()
+
+func main() {
+	addr := flag.String("addr", ":9090", "адрес для входящих соединений")
+	weight := flag.Float64("weight", 75.0, "вес пользователя в килограммах")
+	height := flag.Float64("height", 1.75, "рост пользователя в метрах")
+	flag.Parse()
+
+	service, err := grpcserver.New(*weight, *height, time.Local)
+	if err != nil {
+		logger.Error("неверные параметры пользователя", "error", err)
+		os.Exit(2)
+	}
+
+	lis, err := net.Listen("tcp", *addr)
+	if err != nil {
+		logger.Error("не удалось открыть адрес", "addr", *addr, "error", err)
+		os.Exit(1)
+	}
+
+	srv := grpcserver.NewGRPCServer(service)
+
+	logger.Info("сервер запущен", "addr", lis.Addr().String())
+	if err := srv.Serve(lis); err != nil {
+		logger.Error("сервер остановлен", "error", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,48 @@
+// Команда trackerd запускает HTTP-сервер с JSON API для записи тренировок
+// и дневной активности и получения сводки за день.
+//
+// Маршруты:
+//
+//	POST /trainings      {"date":"2025-01-14T18:00:00+03:00","data":"6000,Бег,1h00m"}
+//	POST /daysteps       {"date":"2025-01-14T08:00:00+03:00","data":"6000,1h00m"}
+//	GET  /summary/{date} дата в формате 2025-01-14
+//
+// Записи хранятся в памяти процесса.
+package main
+
+import (
+	"flag"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+)
+
+var logger = slog.Default()
+
+func main() {
+	addr := flag.String("addr", ":8080", "адрес для входящих соединений")
+	weight := flag.Float64("weight", 75.0, "вес пользователя в килограммах")
+	height := flag.Float64("height", 1.75, "рост пользователя в метрах")
+	flag.Parse()
+
+	s, err := newServer(*weight, *height, time.Local)
+	if err != nil {
+		logger.Error("неверные параметры пользователя", "error", err)
+		os.Exit(2)
+	}
+
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           s.routes(),
+		ReadHeaderTimeout: 5 * time.Second,
+		ReadTimeout:       10 * time.Second,
+		WriteTimeout:      10 * time.Second,
+	}
+
+	logger.Info("сервер запущен", "addr", *addr)
+	if err := srv.ListenAndServe(); err != nil {
+		logger.Error("сервер остановлен", "error", err)
+		os.Exit(1)
+	}
+}
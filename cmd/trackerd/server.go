@@ -0,0 +1,167 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/Yandex-Practicum/tracker/internal/daysteps"
+	"github.com/Yandex-Practicum/tracker/internal/history"
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+// maxBodySize ограничивает размер тела запроса в байтах.
+const maxBodySize = 1 << 16
+
+// dateLayout — формат даты в пути GET /summary/{date}.
+const dateLayout = "2006-01-02"
+
+// recordRequest — тело запросов POST /trainings и POST /daysteps.
+type recordRequest struct {
+	Date time.Time `json:"date"` // дата записи; если не указана, используется текущее время.
+	Data string    `json:"data"` // запись в строковом формате, например "6000,Бег,1h00m".
+}
+
+// dayResponse — ответ на POST /daysteps.
+type dayResponse struct {
+	Date     time.Time `json:"date"`
+	Steps    int       `json:"steps"`
+	Duration string    `json:"duration"`
+	Distance float64   `json:"distance"`
+	Calories float64   `json:"calories"`
+}
+
+// summaryResponse — ответ на GET /summary/{date}.
+type summaryResponse struct {
+	Date     string  `json:"date"`
+	Steps    int     `json:"steps"`
+	Duration string  `json:"duration"`
+	Distance float64 `json:"distance"`
+	Calories float64 `json:"calories"`
+}
+
+// errorResponse — тело ответа с ошибкой.
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// server обрабатывает HTTP-запросы и хранит записи в журнале пользователя.
+type server struct {
+	history *history.History
+	weight  float64
+	height  float64
+	loc     *time.Location
+	now     func() time.Time
+}
+
+func newServer(weight, height float64, loc *time.Location) (*server, error) {
+	h, err := history.New(weight, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &server{history: h, weight: weight, height: height, loc: loc, now: time.Now}, nil
+}
+
+// routes возвращает обработчик со всеми маршрутами API.
+func (s *server) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /trainings", s.handleTraining)
+	mux.HandleFunc("POST /daysteps", s.handleDaySteps)
+	mux.HandleFunc("GET /summary/{date}", s.handleSummary)
+	return mux
+}
+
+func (s *server) handleTraining(w http.ResponseWriter, r *http.Request) {
+	req, err := s.decodeRecord(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	entry, err := spentcalories.NewTrainingEntry(req.Date, req.Data, s.weight, s.height)
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err)
+		return
+	}
+	s.history.AddTrainingEntry(entry)
+
+	writeJSON(w, http.StatusCreated, entry)
+}
+
+func (s *server) handleDaySteps(w http.ResponseWriter, r *http.Request) {
+	req, err := s.decodeRecord(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	summary, err := daysteps.DayActionInfoE(req.Data, s.weight, s.height)
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err)
+		return
+	}
+	s.history.AddDaySummary(req.Date, summary)
+
+	writeJSON(w, http.StatusCreated, dayResponse{
+		Date:     req.Date,
+		Steps:    summary.Steps,
+		Duration: summary.Duration.String(),
+		Distance: summary.Distance,
+		Calories: summary.Calories,
+	})
+}
+
+func (s *server) handleSummary(w http.ResponseWriter, r *http.Request) {
+	date, err := time.ParseInLocation(dateLayout, r.PathValue("date"), s.loc)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("неверный формат даты, ожидается ГГГГ-ММ-ДД: %w", err))
+		return
+	}
+
+	report := s.history.ReportDay(date)
+
+	writeJSON(w, http.StatusOK, summaryResponse{
+		Date:     date.Format(dateLayout),
+		Steps:    report.Steps,
+		Duration: report.Duration.String(),
+		Distance: report.Distance,
+		Calories: report.Calories,
+	})
+}
+
+// decodeRecord читает тело запроса с записью. Если дата не указана, подставляется текущее время.
+func (s *server) decodeRecord(r *http.Request) (recordRequest, error) {
+	var req recordRequest
+
+	dec := json.NewDecoder(io.LimitReader(r.Body, maxBodySize))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		return recordRequest{}, fmt.Errorf("неверный формат JSON: %w", err)
+	}
+	if req.Data == "" {
+		return recordRequest{}, errors.New("не указано поле data")
+	}
+
+	if req.Date.IsZero() {
+		req.Date = s.now()
+	}
+	req.Date = req.Date.In(s.loc)
+
+	return req, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		logger.Error("не удалось записать ответ", "error", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, errorResponse{Error: err.Error()})
+}
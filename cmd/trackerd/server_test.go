@@ -0,0 +1,159 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ServerTestSuite struct {
+	suite.Suite
+	handler http.Handler
+}
+
+func TestServerSuite(t *testing.T) {
+	suite.Run(t, new(ServerTestSuite))
+}
+
+func (suite *ServerTestSuite) SetupTest() {
+	s, err := newServer(75.0, 1.75, time.UTC)
+	suite.Require().NoError(err)
+	s.now = func() time.Time { return time.Date(2025, time.January, 14, 12, 0, 0, 0, time.UTC) }
+
+	suite.handler = s.routes()
+}
+
+func (suite *ServerTestSuite) do(method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	suite.handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func (suite *ServerTestSuite) TestTraining() {
+	rec := suite.do(http.MethodPost, "/trainings", `{"date":"2025-01-14T18:00:00Z","data":"6000,Бег,1h00m"}`)
+	assert.Equal(suite.T(), http.StatusCreated, rec.Code)
+	assert.Equal(suite.T(), "application/json; charset=utf-8", rec.Header().Get("Content-Type"))
+
+	var got map[string]any
+	assert.NoError(suite.T(), json.Unmarshal(rec.Body.Bytes(), &got))
+	assert.Equal(suite.T(), "Бег", got["activity"])
+	assert.Equal(suite.T(), "1h0m0s", got["duration"])
+	assert.InDelta(suite.T(), 354.375, got["calories"], 1e-9)
+}
+
+func (suite *ServerTestSuite) TestDaySteps() {
+	rec := suite.do(http.MethodPost, "/daysteps", `{"data":"6000,1h00m"}`)
+	assert.Equal(suite.T(), http.StatusCreated, rec.Code)
+
+	var got dayResponse
+	assert.NoError(suite.T(), json.Unmarshal(rec.Body.Bytes(), &got))
+	assert.Equal(suite.T(), 6000, got.Steps)
+	assert.InDelta(suite.T(), 3.9, got.Distance, 1e-9)
+	assert.InDelta(suite.T(), 177.1875, got.Calories, 1e-9)
+
+	// Без даты используется текущее время
+	assert.Equal(suite.T(), time.Date(2025, time.January, 14, 12, 0, 0, 0, time.UTC), got.Date)
+}
+
+func (suite *ServerTestSuite) TestSummary() {
+	suite.do(http.MethodPost, "/daysteps", `{"date":"2025-01-14T08:00:00Z","data":"6000,1h00m"}`)
+	suite.do(http.MethodPost, "/trainings", `{"date":"2025-01-14T18:00:00Z","data":"6000,Бег,1h00m"}`)
+	suite.do(http.MethodPost, "/daysteps", `{"date":"2025-01-15T08:00:00Z","data":"3000,30m"}`)
+
+	rec := suite.do(http.MethodGet, "/summary/2025-01-14", "")
+	assert.Equal(suite.T(), http.StatusOK, rec.Code)
+
+	var got summaryResponse
+	assert.NoError(suite.T(), json.Unmarshal(rec.Body.Bytes(), &got))
+	assert.Equal(suite.T(), summaryResponse{
+		Date:     "2025-01-14",
+		Steps:    12000,
+		Duration: "2h0m0s",
+		Distance: 3.9 + 4.725,
+		Calories: 177.1875 + 354.375,
+	}, got)
+
+	rec = suite.do(http.MethodGet, "/summary/2025-01-16", "")
+	assert.NoError(suite.T(), json.Unmarshal(rec.Body.Bytes(), &got))
+	assert.Equal(suite.T(), 0, got.Steps)
+}
+
+func (suite *ServerTestSuite) TestErrors() {
+	tests := []struct {
+		name    string
+		method  string
+		path    string
+		body    string
+		status  int
+		wantErr string
+	}{
+		{
+			name:    "неверный JSON",
+			method:  http.MethodPost,
+			path:    "/trainings",
+			body:    `{"data":`,
+			status:  http.StatusBadRequest,
+			wantErr: "неверный формат JSON",
+		},
+		{
+			name:    "неизвестное поле",
+			method:  http.MethodPost,
+			path:    "/daysteps",
+			body:    `{"data":"6000,1h00m","weight":80}`,
+			status:  http.StatusBadRequest,
+			wantErr: "неверный формат JSON",
+		},
+		{
+			name:    "нет записи",
+			method:  http.MethodPost,
+			path:    "/daysteps",
+			body:    `{}`,
+			status:  http.StatusBadRequest,
+			wantErr: "не указано поле data",
+		},
+		{
+			name:    "неверная тренировка",
+			method:  http.MethodPost,
+			path:    "/trainings",
+			body:    `{"data":"6000,Плавание,1h00m"}`,
+			status:  http.StatusUnprocessableEntity,
+			wantErr: "неизвестный тип тренировки",
+		},
+		{
+			name:    "неверная дневная запись",
+			method:  http.MethodPost,
+			path:    "/daysteps",
+			body:    `{"data":"abc,1h00m"}`,
+			status:  http.StatusUnprocessableEntity,
+			wantErr: "поле steps",
+		},
+		{
+			name:    "неверная дата",
+			method:  http.MethodGet,
+			path:    "/summary/14.01.2025",
+			status:  http.StatusBadRequest,
+			wantErr: "неверный формат даты",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			rec := suite.do(tt.method, tt.path, tt.body)
+			assert.Equal(suite.T(), tt.status, rec.Code)
+
+			var got errorResponse
+			assert.NoError(suite.T(), json.Unmarshal(rec.Body.Bytes(), &got))
+			assert.Contains(suite.T(), got.Error, tt.wantErr)
+		})
+	}
+
+	rec := suite.do(http.MethodGet, "/trainings", "")
+	assert.Equal(suite.T(), http.StatusMethodNotAllowed, rec.Code)
+}
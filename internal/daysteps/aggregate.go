@@ -0,0 +1,37 @@
+package daysteps
+
+import (
+	"fmt"
+	"time"
+)
+
+// DayTotal — суммарные показатели дневной активности по нескольким записям.
+type DayTotal struct {
+	Records  int           // количество учтённых записей.
+	Steps    int           // суммарное количество шагов.
+	Duration time.Duration // суммарная продолжительность активности.
+	Distance float64       // суммарная дистанция в километрах.
+	Calories float64       // суммарное количество калорий.
+}
+
+// AggregateDay суммирует шаги, дистанцию и калории по записям "шаги,длительность",
+// полученным от шагомера в течение одного дня. При ошибке в любой записи
+// возвращается ошибка с номером записи, а итог не рассчитывается.
+func AggregateDay(records []string, weight, height float64) (DayTotal, error) {
+	var total DayTotal
+
+	for i, data := range records {
+		summary, err := DayActionInfoE(data, weight, height)
+		if err != nil {
+			return DayTotal{}, fmt.Errorf("запись %d: %w", i+1, err)
+		}
+
+		total.Records++
+		total.Steps += summary.Steps
+		total.Duration += summary.Duration
+		total.Distance += summary.Distance
+		total.Calories += summary.Calories
+	}
+
+	return total, nil
+}
@@ -0,0 +1,68 @@
+package daysteps
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+func (suite *DayStepsTestSuite) TestAggregateDay() {
+	tests := []struct {
+		name    string
+		records []string
+		want    DayTotal
+		wantErr string
+	}{
+		{
+			name:    "несколько записей",
+			records: []string{"6000,1h00m", "3000,30m", "1000,2h00m"},
+			want: DayTotal{
+				Records:  3,
+				Steps:    10000,
+				Duration: 3*time.Hour + 30*time.Minute,
+				Distance: 6.5,
+				Calories: 177.1875 + 88.59375 + 29.53125,
+			},
+		},
+		{
+			name:    "одна запись",
+			records: []string{"6000,1h00m"},
+			want:    DayTotal{Records: 1, Steps: 6000, Duration: time.Hour, Distance: 3.9, Calories: 177.1875},
+		},
+		{
+			name:    "нет записей",
+			records: nil,
+			want:    DayTotal{},
+		},
+		{
+			name:    "некорректная запись",
+			records: []string{"6000,1h00m", "abc,1h00m"},
+			wantErr: "запись 2",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := AggregateDay(tt.records, 75.0, 1.75)
+
+			if tt.wantErr != "" {
+				assert.ErrorContains(suite.T(), err, tt.wantErr)
+				assert.Equal(suite.T(), DayTotal{}, got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want.Records, got.Records)
+			assert.Equal(suite.T(), tt.want.Steps, got.Steps)
+			assert.Equal(suite.T(), tt.want.Duration, got.Duration)
+			assert.InDelta(suite.T(), tt.want.Distance, got.Distance, 1e-9)
+			assert.InDelta(suite.T(), tt.want.Calories, got.Calories, 1e-9)
+		})
+	}
+
+	// Ошибка записи сохраняет исходную причину
+	_, err := AggregateDay([]string{"0,1h00m"}, 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, spentcalories.ErrNonPositiveSteps)
+}
@@ -0,0 +1,26 @@
+package daysteps
+
+import "fmt"
+
+// SessionsForBadge проверяет, набралось ли за неделю не меньше minSessions корректных
+// записей активности "шаги,длительность", и возвращает их фактическое количество.
+// Некорректные записи не засчитываются, а ошибки возвращаются с указанием дня и записи.
+func SessionsForBadge(days [][]string, minSessions int) (bool, int, []error) {
+	var (
+		count int
+		errs  []error
+	)
+
+	for d, day := range days {
+		for r, data := range day {
+			if _, _, err := parsePackage(data); err != nil {
+				errs = append(errs, fmt.Errorf("день %d, запись %d: %w", d+1, r+1, err))
+				continue
+			}
+
+			count++
+		}
+	}
+
+	return count >= minSessions, count, errs
+}
@@ -0,0 +1,46 @@
+package daysteps
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestSessionsForBadge() {
+	days := [][]string{
+		{"6000,1h00m", "3000,30m"},
+		{},
+		{"abc,1h00m"},
+		{"8000,1h10m"},
+	}
+
+	tests := []struct {
+		name        string
+		minSessions int
+		want        bool
+	}{
+		{
+			name:        "порог ниже количества тренировок",
+			minSessions: 2,
+			want:        true,
+		},
+		{
+			name:        "порог равен количеству тренировок",
+			minSessions: 3,
+			want:        true,
+		},
+		{
+			name:        "порог выше количества тренировок",
+			minSessions: 4,
+			want:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, count, errs := SessionsForBadge(days, tt.minSessions)
+			assert.Equal(suite.T(), tt.want, got)
+			assert.Equal(suite.T(), 3, count)
+			assert.Len(suite.T(), errs, 1)
+			assert.ErrorContains(suite.T(), errs[0], "день 3, запись 1")
+		})
+	}
+}
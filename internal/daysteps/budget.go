@@ -0,0 +1,36 @@
+package daysteps
+
+// WeeklyBudget отслеживает недельную цель по сожжённым калориям.
+type WeeklyBudget struct {
+	target float64
+	burned float64
+}
+
+// NewWeeklyBudget создаёт недельный бюджет с целью target ккал.
+func NewWeeklyBudget(target float64) *WeeklyBudget {
+	return &WeeklyBudget{target: target}
+}
+
+// AddDay добавляет калории дня, рассчитанные так же, как в DayActionInfo.
+// При ошибке в данных бюджет не изменяется.
+func (b *WeeklyBudget) AddDay(data string, weight, height float64) error {
+	_, _, calories, err := dayActivity(data, weight, height)
+	if err != nil {
+		return err
+	}
+
+	b.burned += calories
+
+	return nil
+}
+
+// Burned возвращает количество калорий, сожжённых с начала недели.
+func (b *WeeklyBudget) Burned() float64 {
+	return b.burned
+}
+
+// Remaining возвращает, сколько калорий осталось до цели.
+// Если цель превышена, значение отрицательное и показывает размер превышения.
+func (b *WeeklyBudget) Remaining() float64 {
+	return b.target - b.burned
+}
@@ -0,0 +1,22 @@
+package daysteps
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestWeeklyBudget() {
+	budget := NewWeeklyBudget(500)
+
+	assert.NoError(suite.T(), budget.AddDay("6000,1h00m", 75.0, 1.75))
+	assert.NoError(suite.T(), budget.AddDay("3000,30m", 75.0, 1.75))
+	assert.InDelta(suite.T(), 265.78, budget.Burned(), 0.01)
+	assert.InDelta(suite.T(), 234.22, budget.Remaining(), 0.01)
+
+	// Некорректные данные не меняют бюджет
+	assert.Error(suite.T(), budget.AddDay("abc,1h00m", 75.0, 1.75))
+	assert.InDelta(suite.T(), 234.22, budget.Remaining(), 0.01)
+
+	// Превышение цели даёт отрицательный остаток
+	assert.NoError(suite.T(), budget.AddDay("20000,1h00m", 75.0, 1.75))
+	assert.InDelta(suite.T(), -356.41, budget.Remaining(), 0.01)
+}
@@ -0,0 +1,56 @@
+package daysteps
+
+import (
+	"fmt"
+
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+// CombinedDay рассчитывает суммарные калории за день, в котором кроме обычной ходьбы
+// были отдельные тренировки. dayData — дневная запись "шаги,длительность", trainings —
+// тренировки в формате "шаги,активность,длительность" или записи плавания
+// "круги,плавание,длительность,длина бассейна".
+//
+// Предполагается, что шаги и время тренировок уже учтены в дневной записи, как это
+// делает шагомер, считающий все шаги за день. Поэтому из дневных шагов и длительности
+// вычитаются шаги и длительность тренировок, а калории ходьбы считаются только по остатку.
+// Тренировки без шагов, например велосипед и плавание, шагомер не учитывает, поэтому из дневной
+// записи они не вычитаются. Если тренировки покрывают все дневные шаги, калории ходьбы
+// не добавляются.
+func CombinedDay(dayData string, trainings []string, weight, height float64) (float64, error) {
+	steps, duration, err := parsePackage(dayData)
+	if err != nil {
+		return 0, err
+	}
+
+	var total float64
+	for i, data := range trainings {
+		t, err := spentcalories.NewTraining(data, weight, height)
+		if err != nil {
+			return 0, fmt.Errorf("тренировка %d: %w", i+1, err)
+		}
+
+		total += t.Calories
+
+		// Исключаем шаги и время тренировки из дневной ходьбы
+		if spentcalories.IsStepActivity(t.Activity) {
+			steps -= t.Steps
+			duration -= t.Duration
+		}
+	}
+
+	// Все шаги за день пришлись на тренировки
+	if steps <= 0 {
+		return total, nil
+	}
+	if duration <= 0 {
+		return 0, fmt.Errorf("длительность тренировок превышает длительность активности за день")
+	}
+
+	walking, err := spentcalories.WalkingSpentCalories(steps, weight, height, duration)
+	if err != nil {
+		return 0, err
+	}
+
+	return total + walking, nil
+}
@@ -0,0 +1,84 @@
+package daysteps
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestCombinedDay() {
+	tests := []struct {
+		name      string
+		day       string
+		trainings []string
+		want      float64
+		wantErr   bool
+	}{
+		{
+			name:      "без тренировок",
+			day:       "6000,1h00m",
+			trainings: nil,
+			want:      177.1875,
+			wantErr:   false,
+		},
+		{
+			name:      "шаги пробежки вычитаются из дневных",
+			day:       "12000,2h00m",
+			trainings: []string{"6000,Бег,1h00m"},
+			want:      354.375 + 177.1875,
+			wantErr:   false,
+		},
+		{
+			name:      "тренировки покрывают весь день",
+			day:       "6000,1h00m",
+			trainings: []string{"6000,Бег,1h00m"},
+			want:      354.375,
+			wantErr:   false,
+		},
+		{
+			name:      "обороты педалей не вычитаются из дневных шагов",
+			day:       "10000,3h00m",
+			trainings: []string{"3000,велосипед,30m"},
+			want:      592.5 + 295.3125,
+			wantErr:   false,
+		},
+		{
+			name:      "круги плавания не вычитаются из дневных шагов",
+			day:       "10000,3h00m",
+			trainings: []string{"40,плавание,30m,25"},
+			want:      232.5 + 295.3125,
+			wantErr:   false,
+		},
+		{
+			name:      "тренировки длиннее дня",
+			day:       "12000,1h00m",
+			trainings: []string{"6000,Бег,1h00m"},
+			wantErr:   true,
+		},
+		{
+			name:      "некорректная тренировка",
+			day:       "12000,2h00m",
+			trainings: []string{"6000,Плавание,1h00m"},
+			wantErr:   true,
+		},
+		{
+			name:      "некорректная дневная запись",
+			day:       "12000",
+			trainings: nil,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := CombinedDay(tt.day, tt.trainings, 75.0, 1.75)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Equal(suite.T(), 0.0, got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package daysteps
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+// DayRecord — дневная запись активности, разобранная из CSV-файла.
+type DayRecord struct {
+	Line     int           // номер строки в файле, начиная с 1.
+	Steps    int           // количество шагов.
+	Duration time.Duration // продолжительность активности.
+}
+
+// ParsePackagesCSV разбирает CSV-файл дневной активности, например экспорт шагомера.
+// Первая строка — заголовок со столбцами steps и duration в любом порядке, остальные
+// столбцы игнорируются. Записи проверяются по тем же правилам, что и ParsePackage;
+// ошибка содержит номер строки файла.
+func ParsePackagesCSV(r io.Reader) ([]DayRecord, error) {
+	reader := csv.NewReader(r)
+
+	// Находим положение обязательных столбцов по заголовку
+	columns, err := spentcalories.ReadCSVHeader(reader, "steps", "duration")
+	if err != nil {
+		return nil, err
+	}
+
+	var records []DayRecord
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("неверный формат CSV: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		steps, duration, err := parsePackageFields(row[columns[0]], row[columns[1]])
+		if err != nil {
+			// Дополняем ошибку разбора номером строки файла
+			var parseErr *spentcalories.ParseError
+			if errors.As(err, &parseErr) {
+				parseErr.Line = line
+			}
+			return nil, err
+		}
+
+		records = append(records, DayRecord{
+			Line:     line,
+			Steps:    steps,
+			Duration: duration,
+		})
+	}
+
+	return records, nil
+}
@@ -0,0 +1,77 @@
+package daysteps
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+func (suite *DayStepsTestSuite) TestParsePackagesCSV() {
+	tests := []struct {
+		name      string
+		input     string
+		want      []DayRecord
+		wantLine  int
+		wantField string
+		wantErr   bool
+	}{
+		{
+			name:  "корректный файл",
+			input: "steps,duration\n678,0h50m\n3000+4200,2h00m\n",
+			want: []DayRecord{
+				{Line: 2, Steps: 678, Duration: 50 * time.Minute},
+				{Line: 3, Steps: 7200, Duration: 2 * time.Hour},
+			},
+		},
+		{
+			name:  "лишние столбцы",
+			input: "date,duration,steps\n2025-01-06,1h00m,6000\n",
+			want: []DayRecord{
+				{Line: 2, Steps: 6000, Duration: time.Hour},
+			},
+		},
+		{
+			name:      "некорректные шаги",
+			input:     "steps,duration\n678,0h50m\n-5,1h00m\n",
+			wantLine:  3,
+			wantField: spentcalories.FieldSteps,
+		},
+		{
+			name:      "некорректная длительность",
+			input:     "steps,duration\n678,вечер\n",
+			wantLine:  2,
+			wantField: spentcalories.FieldDuration,
+		},
+		{
+			name:    "нет заголовка",
+			input:   "678,0h50m\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := ParsePackagesCSV(strings.NewReader(tt.input))
+
+			if tt.wantField != "" {
+				var parseErr *spentcalories.ParseError
+				assert.True(suite.T(), errors.As(err, &parseErr))
+				assert.Equal(suite.T(), tt.wantLine, parseErr.Line)
+				assert.Equal(suite.T(), tt.wantField, parseErr.Field)
+				assert.Contains(suite.T(), err.Error(), "строка")
+				return
+			}
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
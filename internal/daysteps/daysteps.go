@@ -2,7 +2,7 @@ package daysteps
 
 import (
 	"fmt"
-	"log"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -10,62 +10,254 @@ import (
 	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
 )
 
+// dayReports — шаблоны отчёта о дневной активности на поддерживаемых языках.
+var dayReports = map[spentcalories.Locale]string{
+	spentcalories.LocaleRu: "Количество шагов: %d.\nДистанция составила %s %s.\nВы сожгли %s ккал.\n",
+	spentcalories.LocaleEn: "Steps: %d.\nDistance: %s %s.\nCalories burned: %s kcal.\n",
+}
+
 const (
-<<<<<<< HEAD
 	// Длина шага в метрах
-=======
-	// Длина одного шага в метрах
->>>>>>> 12cb941bbaf98c3256a955b967a1834163ddfd21
 	stepLength = 0.65
 	// Количество метров в одном километре
 	mInKm = 1000
+	// Вид активности для расчета калорий дневной ходьбы
+	walkingActivity = "ходьба"
 )
 
+// ParsePackage разбирает дневную запись "шаги,длительность".
+// При любых входных данных функция возвращает ошибку, а не паникует.
+func ParsePackage(data string) (int, time.Duration, error) {
+	return parsePackage(data)
+}
+
 func parsePackage(data string) (int, time.Duration, error) {
 	parts := strings.Split(data, ",")
 	if len(parts) != 2 {
-		return 0, 0, fmt.Errorf("неверный формат данных, ожидается 'шаги,длительность'")
+		return 0, 0, parseError(spentcalories.FieldRecord, data, fmt.Errorf("%w, ожидается 'шаги,длительность'", spentcalories.ErrInvalidFormat))
 	}
 
-	steps, err := strconv.Atoi(parts[0]) // БЕЗ TrimSpace
+	return parsePackageFields(parts[0], parts[1])
+}
+
+// parsePackageFields разбирает и проверяет поля дневной записи: шаги и длительность.
+func parsePackageFields(stepsRaw, durationRaw string) (int, time.Duration, error) {
+	steps, err := parseSteps(stepsRaw) // БЕЗ TrimSpace
 	if err != nil {
-		return 0, 0, err
+		return 0, 0, parseError(spentcalories.FieldSteps, stepsRaw, err)
 	}
-	if steps <= 0 {
-		return 0, 0, fmt.Errorf("количество шагов должно быть больше 0")
+	if err := checkSteps(steps, stepsRaw); err != nil {
+		return 0, 0, err
 	}
 
-	duration, err := time.ParseDuration(strings.TrimSpace(parts[1]))
+	duration, err := spentcalories.ParseDuration(strings.TrimSpace(durationRaw))
 	if err != nil {
+		return 0, 0, parseError(spentcalories.FieldDuration, durationRaw, err)
+	}
+	if err := checkDuration(duration, durationRaw); err != nil {
 		return 0, 0, err
 	}
+
+	return steps, duration, nil
+}
+
+func checkSteps(steps int, raw string) error {
+	if steps <= 0 {
+		return parseError(spentcalories.FieldSteps, raw, spentcalories.ErrNonPositiveSteps)
+	}
+	return nil
+}
+
+func checkDuration(duration time.Duration, raw string) error {
 	if duration <= 0 {
-		return 0, 0, fmt.Errorf("длительность должна быть больше 0")
+		return parseError(spentcalories.FieldDuration, raw, spentcalories.ErrNonPositiveDuration)
 	}
+	return nil
+}
 
-	return steps, duration, nil
+// parseSteps разбирает количество шагов. Несколько положительных значений,
+// соединённых знаком "+", например "3000+4200+1500", суммируются.
+func parseSteps(s string) (int, error) {
+	// Одиночное значение, в том числе со знаком "+", разбираем как обычное число
+	if !strings.Contains(strings.TrimPrefix(s, "+"), "+") {
+		return strconv.Atoi(s)
+	}
+
+	total := 0
+	for _, part := range strings.Split(s, "+") {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return 0, fmt.Errorf("неверный формат слагаемого %q: %w", part, err)
+		}
+		if n <= 0 {
+			return 0, fmt.Errorf("слагаемое %q должно быть больше 0", part)
+		}
+
+		// Защищаемся от переполнения при сложении
+		if total > math.MaxInt-n {
+			return 0, fmt.Errorf("сумма шагов слишком велика")
+		}
+		total += n
+	}
+
+	return total, nil
 }
 
+func parseError(field, raw string, err error) error {
+	return &spentcalories.ParseError{Field: field, Raw: raw, Err: err}
+}
+
+// DayActionInfo формирует отчёт о дневной активности. При ошибке она записывается в лог,
+// а функция возвращает пустую строку.
+//
+// Deprecated: пустая строка не позволяет отличить ошибку от пустого результата.
+// Используйте DayActionInfoE для структурированного результата или DayActionInfoErr для строки.
 func DayActionInfo(data string, weight, height float64) string {
-	steps, duration, err := parsePackage(data)
+	info, err := DayActionInfoErr(data, weight, height)
 	if err != nil {
-		log.Println(err)
+		logger().Error("ошибка расчета дневной активности", "record", data, "error", err)
 		return ""
 	}
 
-	distanceKm := float64(steps) * stepLength / mInKm
+	return info
+}
 
-	calories, _ := spentcalories.WalkingSpentCalories(
-		steps,
-		weight,
-		height,
-		duration,
-	)
+// DayActionInfoErr работает как DayActionInfo, но вместо записи в лог и пустой строки
+// возвращает ошибку разбора данных или расчета калорий.
+func DayActionInfoErr(data string, weight, height float64) (string, error) {
+	return dayActionInfo(data, spentcalories.DefaultFormatOptions(), func(steps int, duration time.Duration) (DaySummary, error) {
+		return CalculateDay(steps, duration, weight, height)
+	})
+}
+
+// DayActionInfoWithStepLength работает как DayActionInfoErr, но рассчитывает дистанцию
+// и калории по одной длине шага, как CalculateDayWithStepLength: по измеренной measured
+// в метрах или, если measured равна 0, по росту.
+func DayActionInfoWithStepLength(data string, weight, height, measured float64) (string, error) {
+	return dayActionInfo(data, spentcalories.DefaultFormatOptions(), func(steps int, duration time.Duration) (DaySummary, error) {
+		return CalculateDayWithStepLength(steps, duration, weight, height, measured)
+	})
+}
+
+// DayActionInfoFormat работает как DayActionInfo, но форматирует числа с заданной точностью
+// и разделителем дробной части, на языке и в единицах из настроек. Для дистанции используется
+// DistancePrecision, для калорий — CaloriesPrecision.
+func DayActionInfoFormat(data string, weight, height float64, opts spentcalories.FormatOptions) string {
+	info, err := dayActionInfo(data, opts, func(steps int, duration time.Duration) (DaySummary, error) {
+		return CalculateDay(steps, duration, weight, height)
+	})
+	if err != nil {
+		logger().Error("ошибка расчета дневной активности", "record", data, "error", err)
+		return ""
+	}
+
+	return info
+}
+
+// dayActionInfo разбирает дневную запись, рассчитывает показатели функцией calculate
+// и формирует по ним отчёт.
+func dayActionInfo(data string, opts spentcalories.FormatOptions, calculate func(int, time.Duration) (DaySummary, error)) (string, error) {
+	if err := opts.Validate(); err != nil {
+		return "", err
+	}
+
+	steps, duration, err := parsePackage(data)
+	if err != nil {
+		return "", err
+	}
+
+	summary, err := calculate(steps, duration)
+	if err != nil {
+		return "", err
+	}
+	distanceKm, calories := summary.Distance, summary.Calories
+
+	locale := opts.LocaleOrDefault()
 
 	return fmt.Sprintf(
-		"Количество шагов: %d.\nДистанция составила %.2f км.\nВы сожгли %.2f ккал.\n",
+		dayReports[locale],
 		steps,
-		distanceKm,
-		calories,
-	)
+		opts.FormatFloat(opts.Units.FromKm(distanceKm), opts.DistancePrecision),
+		opts.Units.DistanceLabel(string(locale)),
+		opts.FormatFloat(calories, opts.CaloriesPrecision),
+	), nil
+}
+
+// DaySummary — показатели дневной активности.
+type DaySummary struct {
+	Steps    int           // количество шагов.
+	Duration time.Duration // продолжительность активности.
+	Distance float64       // дистанция в километрах.
+	Calories float64       // количество сожжённых калорий.
+}
+
+// DayActionInfoE разбирает дневную запись "шаги,длительность" и возвращает показатели
+// активности в виде структуры. В отличие от DayActionInfo, ошибки разбора данных
+// и расчета калорий возвращаются вызывающему коду.
+func DayActionInfoE(data string, weight, height float64) (DaySummary, error) {
+	steps, duration, err := parsePackage(data)
+	if err != nil {
+		return DaySummary{}, err
+	}
+
+	return CalculateDay(steps, duration, weight, height)
+}
+
+// CalculateDay рассчитывает показатели дневной активности по уже разобранным шагам
+// и длительности и проверяет их по тем же правилам, что и строковый формат.
+// Дистанция считается по средней длине шага 0.65 м, а калории — как у ходьбы
+// в spentcalories, по длине шага из роста. Для расчета обоих показателей
+// по одной длине шага используйте CalculateDayWithStepLength.
+func CalculateDay(steps int, duration time.Duration, weight, height float64) (DaySummary, error) {
+	if err := checkSteps(steps, strconv.Itoa(steps)); err != nil {
+		return DaySummary{}, err
+	}
+	if err := checkDuration(duration, duration.String()); err != nil {
+		return DaySummary{}, err
+	}
+
+	calories, err := spentcalories.WalkingSpentCalories(steps, weight, height, duration)
+	if err != nil {
+		return DaySummary{}, err
+	}
+
+	return DaySummary{
+		Steps:    steps,
+		Duration: duration,
+		Distance: float64(steps) * stepLength / mInKm,
+		Calories: calories,
+	}, nil
+}
+
+// CalculateDayWithStepLength работает как CalculateDay, но рассчитывает дистанцию и калории
+// по одной длине шага: измеренной measured в метрах или, если measured равна 0, по росту,
+// как для тренировки "Ходьба" в spentcalories.
+func CalculateDayWithStepLength(steps int, duration time.Duration, weight, height, measured float64) (DaySummary, error) {
+	if err := checkSteps(steps, strconv.Itoa(steps)); err != nil {
+		return DaySummary{}, err
+	}
+	if err := checkDuration(duration, duration.String()); err != nil {
+		return DaySummary{}, err
+	}
+
+	walk, err := spentcalories.CalculateTrainingWithStepLength(steps, walkingActivity, duration, weight, height, measured)
+	if err != nil {
+		return DaySummary{}, err
+	}
+
+	return DaySummary{
+		Steps:    steps,
+		Duration: duration,
+		Distance: walk.Distance,
+		Calories: walk.Calories,
+	}, nil
+}
+
+func dayActivity(data string, weight, height float64) (int, float64, float64, error) {
+	summary, err := DayActionInfoE(data, weight, height)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return summary.Steps, summary.Distance, summary.Calories, nil
 }
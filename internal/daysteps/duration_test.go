@@ -0,0 +1,25 @@
+package daysteps
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+func (suite *DayStepsTestSuite) TestParsePackageBareDuration() {
+	defer func() {
+		assert.NoError(suite.T(), spentcalories.SetBareDurationUnit(0))
+	}()
+
+	_, _, err := parsePackage("678,1.5")
+	assert.Error(suite.T(), err)
+
+	assert.NoError(suite.T(), spentcalories.SetBareDurationUnit(time.Hour))
+
+	steps, duration, err := parsePackage("678,1.5")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 678, steps)
+	assert.Equal(suite.T(), 90*time.Minute, duration)
+}
@@ -0,0 +1,38 @@
+package daysteps
+
+import (
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+func (suite *DayStepsTestSuite) TestSentinelErrors() {
+	tests := []struct {
+		name  string
+		input string
+		want  error
+	}{
+		{
+			name:  "неверное количество полей",
+			input: "6000",
+			want:  spentcalories.ErrInvalidFormat,
+		},
+		{
+			name:  "нулевые шаги",
+			input: "0,1h00m",
+			want:  spentcalories.ErrNonPositiveSteps,
+		},
+		{
+			name:  "нулевая длительность",
+			input: "6000,0h00m",
+			want:  spentcalories.ErrNonPositiveDuration,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			_, _, err := ParsePackage(tt.input)
+			assert.ErrorIs(suite.T(), err, tt.want)
+		})
+	}
+}
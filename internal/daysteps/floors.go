@@ -0,0 +1,24 @@
+package daysteps
+
+// Параметры лестничного пролёта.
+const (
+	floorHeightM = 3.0  // высота одного этажа в метрах.
+	stairTreadM  = 0.28 // глубина ступени в метрах.
+)
+
+// StepsPerFloor возвращает количество шагов по ровной поверхности, эквивалентное подъёму
+// на один этаж. По правилу Блонделя подъём на ступень высотой riserHeightM требует
+// столько же усилий, сколько проход расстояния 2*riserHeightM+stairTreadM по горизонтали.
+func StepsPerFloor(riserHeightM, stepLengthM float64) float64 {
+	if riserHeightM <= 0 || stepLengthM <= 0 {
+		return 0
+	}
+
+	// Количество ступеней в одном этаже
+	stairs := floorHeightM / riserHeightM
+
+	// Горизонтальный эквивалент одной ступени
+	stairEquivalentM := 2*riserHeightM + stairTreadM
+
+	return stairs * stairEquivalentM / stepLengthM
+}
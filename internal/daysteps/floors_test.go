@@ -0,0 +1,46 @@
+package daysteps
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestStepsPerFloor() {
+	tests := []struct {
+		name         string
+		riserHeightM float64
+		stepLengthM  float64
+		want         float64
+	}{
+		{
+			name:         "стандартная ступень и шаг",
+			riserHeightM: 0.17,
+			stepLengthM:  stepLength,
+			want:         16.83,
+		},
+		{
+			name:         "высокая ступень",
+			riserHeightM: 0.2,
+			stepLengthM:  stepLength,
+			want:         15.69,
+		},
+		{
+			name:         "нулевая высота ступени",
+			riserHeightM: 0,
+			stepLengthM:  stepLength,
+			want:         0,
+		},
+		{
+			name:         "нулевая длина шага",
+			riserHeightM: 0.17,
+			stepLengthM:  0,
+			want:         0,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got := StepsPerFloor(tt.riserHeightM, tt.stepLengthM)
+			assert.InDelta(suite.T(), tt.want, got, 0.01)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package daysteps
+
+import (
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+	"github.com/Yandex-Practicum/tracker/internal/units"
+)
+
+func (suite *DayStepsTestSuite) TestDayActionInfoFormat() {
+	tests := []struct {
+		name  string
+		input string
+		opts  spentcalories.FormatOptions
+		want  string
+	}{
+		{
+			name:  "настройки по умолчанию",
+			input: "6000,1h00m",
+			opts:  spentcalories.DefaultFormatOptions(),
+			want:  "Количество шагов: 6000.\nДистанция составила 3.90 км.\nВы сожгли 177.19 ккал.\n",
+		},
+		{
+			name:  "целые калории и три знака для дистанции",
+			input: "6000,1h00m",
+			opts:  spentcalories.FormatOptions{DistancePrecision: 3, CaloriesPrecision: 0},
+			want:  "Количество шагов: 6000.\nДистанция составила 3.900 км.\nВы сожгли 177 ккал.\n",
+		},
+		{
+			name:  "запятая в качестве разделителя",
+			input: "6000,1h00m",
+			opts:  spentcalories.FormatOptions{DistancePrecision: 2, CaloriesPrecision: 1, DecimalSeparator: spentcalories.DecimalComma},
+			want:  "Количество шагов: 6000.\nДистанция составила 3,90 км.\nВы сожгли 177,2 ккал.\n",
+		},
+		{
+			name:  "английский язык",
+			input: "6000,1h00m",
+			opts:  spentcalories.FormatOptions{DistancePrecision: 2, CaloriesPrecision: 2, Locale: spentcalories.LocaleEn},
+			want:  "Steps: 6000.\nDistance: 3.90 km.\nCalories burned: 177.19 kcal.\n",
+		},
+		{
+			name:  "неподдерживаемый язык",
+			input: "6000,1h00m",
+			opts:  spentcalories.FormatOptions{Locale: "de"},
+			want:  "",
+		},
+		{
+			name:  "мили",
+			input: "6000,1h00m",
+			opts:  spentcalories.FormatOptions{DistancePrecision: 2, CaloriesPrecision: 2, Units: units.Imperial},
+			want:  "Количество шагов: 6000.\nДистанция составила 2.42 миль.\nВы сожгли 177.19 ккал.\n",
+		},
+		{
+			name:  "мили по-английски",
+			input: "6000,1h00m",
+			opts:  spentcalories.FormatOptions{DistancePrecision: 2, CaloriesPrecision: 2, Locale: spentcalories.LocaleEn, Units: units.Imperial},
+			want:  "Steps: 6000.\nDistance: 2.42 mi.\nCalories burned: 177.19 kcal.\n",
+		},
+		{
+			name:  "неизвестная система измерения",
+			input: "6000,1h00m",
+			opts:  spentcalories.FormatOptions{Units: "nautical"},
+			want:  "",
+		},
+		{
+			name:  "отрицательная точность",
+			input: "6000,1h00m",
+			opts:  spentcalories.FormatOptions{CaloriesPrecision: -1},
+			want:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got := DayActionInfoFormat(tt.input, 75.0, 1.75, tt.opts)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
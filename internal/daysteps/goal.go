@@ -0,0 +1,72 @@
+package daysteps
+
+import (
+	"fmt"
+	"math"
+)
+
+// Параметры адаптивной цели по шагам.
+const (
+	defaultStepGoal    = 10000 // цель по умолчанию, если нет истории.
+	stepGoalIncrease   = 1.05  // во сколько раз цель превышает среднее значение.
+	stepGoalRoundingTo = 100   // шаг округления цели вверх.
+)
+
+// DayActionInfoGoal дополняет отчёт DayActionInfo процентом выполнения цели по шагам
+// и количеством оставшихся шагов. При goalSteps <= 0 дополнительные строки не выводятся.
+func DayActionInfoGoal(data string, weight, height float64, goalSteps int) string {
+	info := DayActionInfo(data, weight, height)
+	if info == "" || goalSteps <= 0 {
+		return info
+	}
+
+	// Данные уже прошли проверку в DayActionInfo
+	steps, _, _ := parsePackage(data)
+
+	percent := float64(steps) / float64(goalSteps) * 100
+
+	// Когда цель достигнута, оставшихся шагов нет
+	remaining := goalSteps - steps
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	return info + fmt.Sprintf(
+		"Цель выполнена на %.0f%%.\nОсталось %d шагов.\n",
+		percent,
+		remaining,
+	)
+}
+
+// AdaptiveStepGoal возвращает цель по шагам на завтра немного выше среднего
+// за последние дни. Если истории нет, возвращается цель по умолчанию — 10000 шагов.
+func AdaptiveStepGoal(recentDailySteps []int) int {
+	if len(recentDailySteps) == 0 {
+		return defaultStepGoal
+	}
+
+	total := 0
+	for _, steps := range recentDailySteps {
+		total += steps
+	}
+
+	average := float64(total) / float64(len(recentDailySteps))
+	if average <= 0 {
+		return defaultStepGoal
+	}
+
+	// Повышаем цель и округляем её вверх до сотни шагов
+	goal := math.Ceil(average*stepGoalIncrease/stepGoalRoundingTo) * stepGoalRoundingTo
+
+	return int(goal)
+}
+
+// StepsToMatchYesterday возвращает, сколько шагов осталось пройти сегодня,
+// чтобы сравняться со вчерашним днём. Если результат уже достигнут, возвращается 0.
+func StepsToMatchYesterday(yesterdaySteps, todaySteps int) int {
+	if todaySteps >= yesterdaySteps {
+		return 0
+	}
+
+	return yesterdaySteps - todaySteps
+}
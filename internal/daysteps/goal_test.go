@@ -0,0 +1,122 @@
+package daysteps
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestDayActionInfoGoal() {
+	tests := []struct {
+		name      string
+		input     string
+		goalSteps int
+		want      string
+	}{
+		{
+			name:      "цель не достигнута",
+			input:     "6000,1h00m",
+			goalSteps: 10000,
+			want:      "Количество шагов: 6000.\nДистанция составила 3.90 км.\nВы сожгли 177.19 ккал.\nЦель выполнена на 60%.\nОсталось 4000 шагов.\n",
+		},
+		{
+			name:      "цель перевыполнена",
+			input:     "12000,2h00m",
+			goalSteps: 10000,
+			want:      "Количество шагов: 12000.\nДистанция составила 7.80 км.\nВы сожгли 354.38 ккал.\nЦель выполнена на 120%.\nОсталось 0 шагов.\n",
+		},
+		{
+			name:      "нулевая цель",
+			input:     "6000,1h00m",
+			goalSteps: 0,
+			want:      "Количество шагов: 6000.\nДистанция составила 3.90 км.\nВы сожгли 177.19 ккал.\n",
+		},
+		{
+			name:      "отрицательная цель",
+			input:     "6000,1h00m",
+			goalSteps: -100,
+			want:      "Количество шагов: 6000.\nДистанция составила 3.90 км.\nВы сожгли 177.19 ккал.\n",
+		},
+		{
+			name:      "некорректный формат",
+			input:     "not valid",
+			goalSteps: 10000,
+			want:      "",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got := DayActionInfoGoal(tt.input, 75.0, 1.75, tt.goalSteps)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
+
+func (suite *DayStepsTestSuite) TestAdaptiveStepGoal() {
+	tests := []struct {
+		name   string
+		recent []int
+		want   int
+	}{
+		{
+			name:   "нет истории",
+			recent: nil,
+			want:   10000,
+		},
+		{
+			name:   "растущая активность",
+			recent: []int{5000, 6000, 7000, 8000, 9000},
+			want:   7400,
+		},
+		{
+			name:   "стабильная активность",
+			recent: []int{10000, 10000, 10000},
+			want:   10500,
+		},
+		{
+			name:   "нулевая активность",
+			recent: []int{0, 0},
+			want:   10000,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got := AdaptiveStepGoal(tt.recent)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
+
+func (suite *DayStepsTestSuite) TestStepsToMatchYesterday() {
+	tests := []struct {
+		name      string
+		yesterday int
+		today     int
+		want      int
+	}{
+		{
+			name:      "вчерашний результат ещё не достигнут",
+			yesterday: 8000,
+			today:     5500,
+			want:      2500,
+		},
+		{
+			name:      "вчерашний результат превышен",
+			yesterday: 8000,
+			today:     9000,
+			want:      0,
+		},
+		{
+			name:      "результаты равны",
+			yesterday: 8000,
+			today:     8000,
+			want:      0,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.want, StepsToMatchYesterday(tt.yesterday, tt.today))
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package daysteps
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+func (suite *DayStepsTestSuite) TestDayActionInfoErr() {
+	tests := []struct {
+		name    string
+		input   string
+		weight  float64
+		height  float64
+		want    string
+		wantErr string
+	}{
+		{
+			name:   "корректные данные",
+			input:  "6000,1h00m",
+			weight: 75.0,
+			height: 1.75,
+			want:   "Количество шагов: 6000.\nДистанция составила 3.90 км.\nВы сожгли 177.19 ккал.\n",
+		},
+		{
+			name:    "неверный формат",
+			input:   "not valid",
+			weight:  75.0,
+			height:  1.75,
+			wantErr: "неверный формат данных",
+		},
+		{
+			name:    "нулевой вес",
+			input:   "6000,1h00m",
+			weight:  0,
+			height:  1.75,
+			wantErr: "вес должен быть больше 0",
+		},
+		{
+			name:    "рост в сантиметрах",
+			input:   "6000,1h00m",
+			weight:  75.0,
+			height:  175,
+			wantErr: "сантиметрах",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := DayActionInfoErr(tt.input, tt.weight, tt.height)
+
+			if tt.wantErr != "" {
+				assert.ErrorContains(suite.T(), err, tt.wantErr)
+				assert.Empty(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
+
+func (suite *DayStepsTestSuite) TestDayActionInfoCaloriesError() {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	// Ошибка расчета калорий больше не скрывается нулевым значением
+	got := DayActionInfo("6000,1h00m", 0, 1.75)
+	assert.Empty(suite.T(), got)
+	assert.Contains(suite.T(), buf.String(), "вес должен быть больше 0")
+}
+
+func (suite *DayStepsTestSuite) TestDayActionInfoE() {
+	got, err := DayActionInfoE("6000,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 6000, got.Steps)
+	assert.Equal(suite.T(), time.Hour, got.Duration)
+	assert.InDelta(suite.T(), 3.9, got.Distance, 1e-9)
+	assert.InDelta(suite.T(), 177.1875, got.Calories, 1e-9)
+
+	got, err = DayActionInfoE("", 75.0, 1.75)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), DaySummary{}, got)
+
+	_, err = DayActionInfoE("6000,1h00m", 0, 1.75)
+	assert.ErrorContains(suite.T(), err, "вес должен быть больше 0")
+}
+
+func (suite *DayStepsTestSuite) TestCalculateDay() {
+	got, err := CalculateDay(6000, time.Hour, 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 3.9, got.Distance, 1e-9)
+	assert.InDelta(suite.T(), 177.1875, got.Calories, 1e-9)
+
+	_, err = CalculateDay(-1, time.Hour, 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, spentcalories.ErrNonPositiveSteps)
+
+	_, err = CalculateDay(6000, 0, 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, spentcalories.ErrNonPositiveDuration)
+}
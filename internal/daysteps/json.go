@@ -0,0 +1,86 @@
+package daysteps
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+// packageJSON — запись дневной активности в формате JSON.
+type packageJSON struct {
+	Steps           int     `json:"steps"`
+	Duration        string  `json:"duration"`
+	DurationSeconds float64 `json:"duration_seconds"`
+}
+
+// ParsePackageJSON разбирает дневную активность в формате
+// {"steps":8700,"duration":"8h00m"} или {"steps":8700,"duration_seconds":28800}
+// и проверяет её по тем же правилам, что и строковый формат ParsePackage.
+func ParsePackageJSON(data []byte) (int, time.Duration, error) {
+	var record packageJSON
+	if err := json.Unmarshal(data, &record); err != nil {
+		return 0, 0, parseError(spentcalories.FieldRecord, string(data), fmt.Errorf("неверный формат JSON: %w", err))
+	}
+
+	if err := checkSteps(record.Steps, strconv.Itoa(record.Steps)); err != nil {
+		return 0, 0, err
+	}
+
+	duration, err := jsonDuration(record.Duration, record.DurationSeconds)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return record.Steps, duration, nil
+}
+
+// ParseDayJSON разбирает дневную активность в формате JSON так же, как ParsePackageJSON.
+func ParseDayJSON(data []byte) (int, time.Duration, error) {
+	return ParsePackageJSON(data)
+}
+
+// jsonDuration возвращает длительность JSON-записи: из строкового поля "duration"
+// в формате spentcalories.ParseDuration или из поля "duration_seconds".
+// Указывать можно только одно из полей.
+func jsonDuration(text string, seconds float64) (time.Duration, error) {
+	if text == "" {
+		return secondsToDuration(seconds)
+	}
+	if seconds != 0 {
+		return 0, parseError(spentcalories.FieldDuration, text, fmt.Errorf("длительность должна быть указана только в одном из полей duration и duration_seconds"))
+	}
+
+	duration, err := spentcalories.ParseDuration(strings.TrimSpace(text))
+	if err != nil {
+		return 0, parseError(spentcalories.FieldDuration, text, fmt.Errorf("неверный формат длительности: %w", err))
+	}
+	if err := checkDuration(duration, text); err != nil {
+		return 0, err
+	}
+
+	return duration, nil
+}
+
+// secondsToDuration переводит длительность в секундах в time.Duration
+// и проверяет, что она положительна.
+func secondsToDuration(seconds float64) (time.Duration, error) {
+	raw := strconv.FormatFloat(seconds, 'f', -1, 64)
+
+	// Проверяем, что длительность помещается в time.Duration
+	nanoseconds := seconds * float64(time.Second)
+	if nanoseconds >= math.MaxInt64 {
+		return 0, parseError(spentcalories.FieldDuration, raw, fmt.Errorf("длительность слишком велика"))
+	}
+
+	duration := time.Duration(nanoseconds)
+	if err := checkDuration(duration, raw); err != nil {
+		return 0, err
+	}
+
+	return duration, nil
+}
@@ -0,0 +1,98 @@
+package daysteps
+
+import (
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+func (suite *DayStepsTestSuite) TestParsePackageJSON() {
+	tests := []struct {
+		name         string
+		input        string
+		wantSteps    int
+		wantDuration time.Duration
+		wantField    string
+		wantErr      bool
+	}{
+		{
+			name:         "корректная запись",
+			input:        `{"steps":8700,"duration_seconds":28800}`,
+			wantSteps:    8700,
+			wantDuration: 8 * time.Hour,
+			wantErr:      false,
+		},
+		{
+			name:         "длительность строкой",
+			input:        `{"steps":8700,"duration":"8h00m"}`,
+			wantSteps:    8700,
+			wantDuration: 8 * time.Hour,
+			wantErr:      false,
+		},
+		{
+			name:      "нулевая длительность строкой",
+			input:     `{"steps":8700,"duration":"0h00m"}`,
+			wantField: spentcalories.FieldDuration,
+			wantErr:   true,
+		},
+		{
+			name:      "некорректный JSON",
+			input:     `not json`,
+			wantField: spentcalories.FieldRecord,
+			wantErr:   true,
+		},
+		{
+			name:      "нулевые шаги",
+			input:     `{"steps":0,"duration_seconds":28800}`,
+			wantField: spentcalories.FieldSteps,
+			wantErr:   true,
+		},
+		{
+			name:      "длительность в обоих полях",
+			input:     `{"steps":8700,"duration":"8h00m","duration_seconds":28800}`,
+			wantField: spentcalories.FieldDuration,
+			wantErr:   true,
+		},
+		{
+			name:      "слишком большая длительность в секундах",
+			input:     `{"steps":8700,"duration_seconds":1e300}`,
+			wantField: spentcalories.FieldDuration,
+			wantErr:   true,
+		},
+		{
+			name:      "нет длительности",
+			input:     `{"steps":8700}`,
+			wantField: spentcalories.FieldDuration,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			steps, duration, err := ParsePackageJSON([]byte(tt.input))
+
+			if tt.wantErr {
+				var parseErr *spentcalories.ParseError
+				assert.True(suite.T(), errors.As(err, &parseErr))
+				assert.Equal(suite.T(), tt.wantField, parseErr.Field)
+				assert.Equal(suite.T(), 0, steps)
+				assert.Equal(suite.T(), time.Duration(0), duration)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.wantSteps, steps)
+			assert.Equal(suite.T(), tt.wantDuration, duration)
+		})
+	}
+}
+
+func (suite *DayStepsTestSuite) TestParseDayJSON() {
+	steps, duration, err := ParseDayJSON([]byte(`{"steps":8700,"duration":"8h00m"}`))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 8700, steps)
+	assert.Equal(suite.T(), 8*time.Hour, duration)
+}
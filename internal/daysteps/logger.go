@@ -0,0 +1,33 @@
+package daysteps
+
+import (
+	"log/slog"
+	"sync"
+)
+
+// Логгер пакета. nil означает slog.Default(), который по умолчанию
+// пишет через стандартный пакет log.
+var (
+	loggerMu  sync.RWMutex
+	pkgLogger *slog.Logger
+)
+
+// SetLogger задаёт логгер для ошибок дневной активности. Записи содержат
+// поля record и error. nil возвращает логгер по умолчанию slog.Default().
+// Чтобы отключить вывод, передайте логгер с обработчиком, отбрасывающим записи.
+func SetLogger(l *slog.Logger) {
+	loggerMu.Lock()
+	defer loggerMu.Unlock()
+
+	pkgLogger = l
+}
+
+func logger() *slog.Logger {
+	loggerMu.RLock()
+	defer loggerMu.RUnlock()
+
+	if pkgLogger == nil {
+		return slog.Default()
+	}
+	return pkgLogger
+}
@@ -0,0 +1,28 @@
+package daysteps
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestSetLogger() {
+	defer SetLogger(nil)
+
+	var buf bytes.Buffer
+	SetLogger(slog.New(slog.NewJSONHandler(&buf, nil)))
+
+	assert.Empty(suite.T(), DayActionInfo("6000", 75.0, 1.75))
+
+	var entry map[string]any
+	assert.NoError(suite.T(), json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(suite.T(), "ERROR", entry["level"])
+	assert.Equal(suite.T(), "6000", entry["record"])
+	assert.Contains(suite.T(), entry["error"], "неверный формат данных")
+
+	buf.Reset()
+	assert.NotEmpty(suite.T(), DayActionInfo("6000,1h00m", 75.0, 1.75))
+	assert.Empty(suite.T(), buf.String())
+}
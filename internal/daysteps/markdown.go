@@ -0,0 +1,25 @@
+package daysteps
+
+import "fmt"
+
+// DayActionInfoMarkdown формирует отчёт о дневной активности в формате Markdown:
+// жирный заголовок и таблицу с шагами, дистанцией и калориями.
+// В отличие от DayActionInfo, при некорректных данных возвращается ошибка.
+func DayActionInfoMarkdown(data string, weight, height float64) (string, error) {
+	steps, distanceKm, calories, err := dayActivity(data, weight, height)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(
+		"**Активность за день**\n\n"+
+			"| Показатель | Значение |\n"+
+			"|---|---|\n"+
+			"| Шаги | %d |\n"+
+			"| Дистанция | %.2f км |\n"+
+			"| Калории | %.2f ккал |\n",
+		steps,
+		distanceKm,
+		calories,
+	), nil
+}
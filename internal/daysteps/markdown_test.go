@@ -0,0 +1,51 @@
+package daysteps
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestDayActionInfoMarkdown() {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "корректные данные",
+			input: "6000,1h00m",
+			want: "**Активность за день**\n\n" +
+				"| Показатель | Значение |\n" +
+				"|---|---|\n" +
+				"| Шаги | 6000 |\n" +
+				"| Дистанция | 3.90 км |\n" +
+				"| Калории | 177.19 ккал |\n",
+			wantErr: false,
+		},
+		{
+			name:    "пустая строка",
+			input:   "",
+			wantErr: true,
+		},
+		{
+			name:    "неверная длительность",
+			input:   "6000,soon",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := DayActionInfoMarkdown(tt.input, 75.0, 1.75)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Empty(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package daysteps
+
+import (
+	"testing"
+)
+
+func FuzzParsePackage(f *testing.F) {
+	for _, input := range []string{
+		"6000,1h00m",
+		"3000+4200+1500,45m",
+		"+12345,1h30m",
+		" 12345,1h30m",
+		"678,30",
+		"",
+		",",
+		"9223372036854775807+1,1h",
+		"1000,-1h00m",
+		"1000,1e400h",
+	} {
+		f.Add(input)
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		steps, duration, err := ParsePackage(input)
+		if err != nil {
+			return
+		}
+
+		// Успешно разобранные данные всегда корректны
+		if steps <= 0 {
+			t.Fatalf("неположительное количество шагов %d для %q", steps, input)
+		}
+		if duration <= 0 {
+			t.Fatalf("неположительная длительность %v для %q", duration, input)
+		}
+	})
+}
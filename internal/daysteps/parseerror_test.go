@@ -0,0 +1,48 @@
+package daysteps
+
+import (
+	"errors"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+func (suite *DayStepsTestSuite) TestParsePackageError() {
+	tests := []struct {
+		name      string
+		input     string
+		wantField string
+		wantRaw   string
+	}{
+		{
+			name:      "неверное количество полей",
+			input:     "678",
+			wantField: spentcalories.FieldRecord,
+			wantRaw:   "678",
+		},
+		{
+			name:      "пробелы в шагах",
+			input:     " 12345,1h30m",
+			wantField: spentcalories.FieldSteps,
+			wantRaw:   " 12345",
+		},
+		{
+			name:      "нулевая длительность",
+			input:     "678,0h0m",
+			wantField: spentcalories.FieldDuration,
+			wantRaw:   "0h0m",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			_, _, err := parsePackage(tt.input)
+
+			var parseErr *spentcalories.ParseError
+			assert.True(suite.T(), errors.As(err, &parseErr))
+			assert.Equal(suite.T(), tt.wantField, parseErr.Field)
+			assert.Equal(suite.T(), tt.wantRaw, parseErr.Raw)
+		})
+	}
+}
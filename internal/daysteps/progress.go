@@ -0,0 +1,50 @@
+package daysteps
+
+import (
+	"fmt"
+
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+// WeeklyDistanceImprovement возвращает изменение суммарной дистанции этой недели
+// относительно прошлой в процентах. Дни содержат записи "шаги,длительность",
+// дистанция оценивается по росту так же, как в отчётах о тренировках.
+// Некорректные записи пропускаются, а ошибки возвращаются с указанием недели, дня и записи.
+// Если за прошлую неделю дистанция нулевая, сравнение невозможно: возвращается 0 и ошибка.
+func WeeklyDistanceImprovement(thisWeek, lastWeek [][]string, height float64) (float64, []error) {
+	var errs []error
+
+	current := weekDistance(thisWeek, height, "эта неделя", &errs)
+	previous := weekDistance(lastWeek, height, "прошлая неделя", &errs)
+
+	// Без базового значения процент изменения не определён
+	if previous == 0 {
+		errs = append(errs, fmt.Errorf("за прошлую неделю нет дистанции для сравнения"))
+		return 0, errs
+	}
+
+	return (current - previous) / previous * 100, errs
+}
+
+func weekDistance(days [][]string, height float64, week string, errs *[]error) float64 {
+	var total float64
+
+	for d, day := range days {
+		for r, data := range day {
+			steps, _, err := parsePackage(data)
+			if err != nil {
+				*errs = append(*errs, fmt.Errorf("%s, день %d, запись %d: %w", week, d+1, r+1, err))
+				continue
+			}
+
+			km, _, err := spentcalories.Distance(steps, height)
+			if err != nil {
+				*errs = append(*errs, fmt.Errorf("%s, день %d, запись %d: %w", week, d+1, r+1, err))
+				continue
+			}
+			total += km
+		}
+	}
+
+	return total
+}
@@ -0,0 +1,26 @@
+package daysteps
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestWeeklyDistanceImprovement() {
+	lastWeek := [][]string{
+		{"5000,50m"},
+		{"5000,50m"},
+	}
+	thisWeek := [][]string{
+		{"6000,1h00m", "abc,1h00m"},
+		{},
+		{"6000,1h00m"},
+	}
+
+	got, errs := WeeklyDistanceImprovement(thisWeek, lastWeek, 1.75)
+	assert.InDelta(suite.T(), 20, got, 1e-9)
+	assert.Len(suite.T(), errs, 1)
+	assert.ErrorContains(suite.T(), errs[0], "эта неделя, день 1, запись 2")
+
+	got, errs = WeeklyDistanceImprovement(thisWeek, nil, 1.75)
+	assert.Equal(suite.T(), 0.0, got)
+	assert.ErrorContains(suite.T(), errs[len(errs)-1], "нет дистанции")
+}
@@ -0,0 +1,37 @@
+package daysteps
+
+import "fmt"
+
+// WeeklyShareText формирует краткую сводку за неделю для публикации.
+// days содержит записи активности по дням в формате "шаги,длительность".
+func WeeklyShareText(days [][]string, weight, height float64) (string, error) {
+	var (
+		totalDistance float64
+		totalCalories float64
+		activeDays    int
+	)
+
+	for i, day := range days {
+		// День считается активным, если в нём есть хотя бы одна запись
+		if len(day) > 0 {
+			activeDays++
+		}
+
+		for j, data := range day {
+			_, distanceKm, calories, err := dayActivity(data, weight, height)
+			if err != nil {
+				return "", fmt.Errorf("день %d, запись %d: %w", i+1, j+1, err)
+			}
+
+			totalDistance += distanceKm
+			totalCalories += calories
+		}
+	}
+
+	return fmt.Sprintf(
+		"За неделю: %.2f км, %.0f ккал, активных дней: %d.",
+		totalDistance,
+		totalCalories,
+		activeDays,
+	), nil
+}
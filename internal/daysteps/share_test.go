@@ -0,0 +1,22 @@
+package daysteps
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestWeeklyShareText() {
+	days := [][]string{
+		{"6000,1h00m", "3000,30m"},
+		{},
+		{"20000,1h00m"},
+	}
+
+	got, err := WeeklyShareText(days, 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "18.85 км")
+	assert.Contains(suite.T(), got, "856 ккал")
+	assert.Contains(suite.T(), got, "активных дней: 2")
+
+	_, err = WeeklyShareText([][]string{{"6000,1h00m"}, {"abc,1h00m"}}, 75.0, 1.75)
+	assert.ErrorContains(suite.T(), err, "день 2, запись 1")
+}
@@ -0,0 +1,37 @@
+package daysteps
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+func (suite *DayStepsTestSuite) TestCalculateDayWithStepLength() {
+	got, err := CalculateDayWithStepLength(6000, time.Hour, 75.0, 1.75, 0.8)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 4.8, got.Distance, 1e-9)
+
+	// Дневная активность и тренировки используют одну и ту же длину шага
+	walk, err := spentcalories.NewTrainingWithStepLength("6000,Ходьба,1h00m", 75.0, 1.75, 0.8)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), walk.Distance, got.Distance, 1e-9)
+
+	// Калории за ходьбу пересчитываются по той же дистанции
+	assert.InDelta(suite.T(), 75*4.8*0.5, got.Calories, 1e-9)
+
+	// Без заданной длины шага дистанция и калории считаются по длине шага из роста
+	got, err = CalculateDayWithStepLength(6000, time.Hour, 75.0, 1.75, 0)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 4.725, got.Distance, 1e-9)
+	assert.InDelta(suite.T(), 75*4.725*0.5, got.Calories, 1e-9)
+
+	walk, err = spentcalories.NewTraining("6000,Ходьба,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), walk.Distance, got.Distance, 1e-9)
+	assert.InDelta(suite.T(), walk.Calories, got.Calories, 1e-9)
+
+	_, err = CalculateDayWithStepLength(6000, time.Hour, 75.0, 1.75, 3)
+	assert.ErrorContains(suite.T(), err, "длина шага")
+}
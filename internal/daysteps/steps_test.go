@@ -0,0 +1,93 @@
+package daysteps
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestParsePackageSummedSteps() {
+	tests := []struct {
+		name      string
+		input     string
+		wantSteps int
+		wantErr   bool
+	}{
+		{
+			name:      "сумма трёх синхронизаций",
+			input:     "3000+4200+1500,8h",
+			wantSteps: 8700,
+			wantErr:   false,
+		},
+		{
+			name:      "сумма двух синхронизаций",
+			input:     "3000+4200,8h",
+			wantSteps: 7200,
+			wantErr:   false,
+		},
+		{
+			name:      "одиночное значение со знаком плюс",
+			input:     "+3000,8h",
+			wantSteps: 3000,
+			wantErr:   false,
+		},
+		{
+			name:    "пустое слагаемое",
+			input:   "3000++1500,8h",
+			wantErr: true,
+		},
+		{
+			name:    "слагаемое в конце отсутствует",
+			input:   "3000+,8h",
+			wantErr: true,
+		},
+		{
+			name:    "знак плюс перед суммой",
+			input:   "+3000+4200,8h",
+			wantErr: true,
+		},
+		{
+			name:    "нулевое слагаемое",
+			input:   "3000+0,8h",
+			wantErr: true,
+		},
+		{
+			name:    "отрицательное слагаемое",
+			input:   "3000+-500,8h",
+			wantErr: true,
+		},
+		{
+			name:    "нечисловое слагаемое",
+			input:   "3000+abc,8h",
+			wantErr: true,
+		},
+		{
+			name:    "пробел в слагаемом",
+			input:   "3000+ 4200,8h",
+			wantErr: true,
+		},
+		{
+			name:    "переполнение суммы",
+			input:   fmt.Sprintf("%d+%d,8h", math.MaxInt, 1),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			gotSteps, gotDuration, err := parsePackage(tt.input)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Equal(suite.T(), 0, gotSteps)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.wantSteps, gotSteps)
+			assert.Equal(suite.T(), 8*time.Hour, gotDuration)
+		})
+	}
+}
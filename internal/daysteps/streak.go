@@ -0,0 +1,101 @@
+package daysteps
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// DayEntry — запись дневной активности с датой.
+type DayEntry struct {
+	Date     time.Time     // дата и время записи.
+	Steps    int           // количество шагов.
+	Duration time.Duration // длительность прогулки.
+}
+
+// NewDayEntry разбирает строку "шаги,длительность" и возвращает запись с указанной датой.
+func NewDayEntry(date time.Time, data string) (DayEntry, error) {
+	steps, duration, err := parsePackage(data)
+	if err != nil {
+		return DayEntry{}, err
+	}
+
+	return DayEntry{Date: date, Steps: steps, Duration: duration}, nil
+}
+
+// calendarDay — календарный день без учёта времени.
+type calendarDay struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+func calendarDayOf(t time.Time) calendarDay {
+	year, month, day := t.Date()
+	return calendarDay{year: year, month: month, day: day}
+}
+
+// CurrentStreak возвращает количество подряд идущих календарных дней, заканчивая сегодняшним,
+// в которые суммарное количество шагов было не меньше minSteps.
+// Дни без записей прерывают серию. Границы дней определяются по местному времени.
+func CurrentStreak(entries []DayEntry, minSteps int) int {
+	return currentStreak(entries, minSteps, time.Now())
+}
+
+func currentStreak(entries []DayEntry, minSteps int, now time.Time) int {
+	loc := now.Location()
+
+	// Суммируем шаги по календарным дням в часовом поясе now
+	totals := make(map[calendarDay]int)
+	for _, e := range entries {
+		totals[calendarDayOf(e.Date.In(loc))] += e.Steps
+	}
+
+	streak := 0
+	year, month, day := now.Date()
+
+	for {
+		// time.Date нормализует дату при переходе через границу месяца или года
+		key := calendarDayOf(time.Date(year, month, day-streak, 12, 0, 0, 0, loc))
+
+		steps, ok := totals[key]
+		if !ok || steps < minSteps {
+			return streak
+		}
+
+		streak++
+	}
+}
+
+// dayEntryJSON — представление DayEntry в JSON с длительностью в виде строки.
+type dayEntryJSON struct {
+	Date     time.Time `json:"date"`
+	Steps    int       `json:"steps"`
+	Duration string    `json:"duration"`
+}
+
+// MarshalJSON сохраняет запись в JSON; длительность записывается строкой, например "1h30m0s".
+func (e DayEntry) MarshalJSON() ([]byte, error) {
+	return json.Marshal(dayEntryJSON{
+		Date:     e.Date,
+		Steps:    e.Steps,
+		Duration: e.Duration.String(),
+	})
+}
+
+// UnmarshalJSON восстанавливает запись, сохранённую MarshalJSON.
+func (e *DayEntry) UnmarshalJSON(data []byte) error {
+	var raw dayEntryJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	duration, err := time.ParseDuration(raw.Duration)
+	if err != nil {
+		return fmt.Errorf("неверный формат длительности: %w", err)
+	}
+
+	*e = DayEntry{Date: raw.Date, Steps: raw.Steps, Duration: duration}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package daysteps
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestNewDayEntry() {
+	date := time.Date(2025, time.March, 1, 20, 0, 0, 0, time.UTC)
+
+	got, err := NewDayEntry(date, "6000,1h00m")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), DayEntry{Date: date, Steps: 6000, Duration: time.Hour}, got)
+
+	_, err = NewDayEntry(date, "abc,1h00m")
+	assert.Error(suite.T(), err)
+}
+
+func (suite *DayStepsTestSuite) TestCurrentStreak() {
+	msk := time.FixedZone("MSK", 3*60*60)
+	now := time.Date(2025, time.March, 2, 15, 0, 0, 0, msk)
+
+	day := func(month time.Month, d, hour, steps int) DayEntry {
+		return DayEntry{Date: time.Date(2025, month, d, hour, 0, 0, 0, msk), Steps: steps}
+	}
+
+	tests := []struct {
+		name     string
+		entries  []DayEntry
+		minSteps int
+		want     int
+	}{
+		{
+			name:     "нет записей",
+			entries:  nil,
+			minSteps: 5000,
+			want:     0,
+		},
+		{
+			name: "серия через границу месяца",
+			entries: []DayEntry{
+				day(time.March, 2, 9, 6000),
+				day(time.March, 1, 9, 7000),
+				day(time.February, 28, 9, 8000),
+				day(time.February, 26, 9, 9000),
+			},
+			minSteps: 5000,
+			want:     3,
+		},
+		{
+			name: "записи за один день суммируются",
+			entries: []DayEntry{
+				day(time.March, 2, 8, 3000),
+				day(time.March, 2, 18, 2500),
+				day(time.March, 1, 9, 4000),
+			},
+			minSteps: 5000,
+			want:     1,
+		},
+		{
+			name: "сегодня цель не выполнена",
+			entries: []DayEntry{
+				day(time.March, 2, 9, 1000),
+				day(time.March, 1, 9, 7000),
+			},
+			minSteps: 5000,
+			want:     0,
+		},
+		{
+			name: "день определяется по местному времени",
+			entries: []DayEntry{
+				// 00:30 по Москве 2 марта — это ещё 1 марта по UTC
+				{Date: time.Date(2025, time.March, 1, 21, 30, 0, 0, time.UTC), Steps: 6000},
+			},
+			minSteps: 5000,
+			want:     1,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got := currentStreak(tt.entries, tt.minSteps, now)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
+
+func (suite *DayStepsTestSuite) TestDayEntryJSON() {
+	entry := DayEntry{
+		Date:     time.Date(2025, time.March, 1, 20, 0, 0, 0, time.UTC),
+		Steps:    8700,
+		Duration: 8*time.Hour + 15*time.Minute,
+	}
+
+	data, err := json.Marshal(entry)
+	assert.NoError(suite.T(), err)
+	assert.JSONEq(suite.T(), `{"date":"2025-03-01T20:00:00Z","steps":8700,"duration":"8h15m0s"}`, string(data))
+
+	var got DayEntry
+	assert.NoError(suite.T(), json.Unmarshal(data, &got))
+	assert.Equal(suite.T(), entry, got)
+
+	assert.Error(suite.T(), json.Unmarshal([]byte(`{"duration":"8"}`), &got))
+}
@@ -0,0 +1,29 @@
+package daysteps
+
+import "fmt"
+
+// MonthlyTotal суммирует калории за месяц, заданный как недели из дней с записями
+// "шаги,длительность". Некорректные записи пропускаются, а ошибки возвращаются
+// с указанием недели, дня и записи.
+func MonthlyTotal(weeks [][][]string, weight, height float64) (float64, []error) {
+	var (
+		total float64
+		errs  []error
+	)
+
+	for w, week := range weeks {
+		for d, day := range week {
+			for r, data := range day {
+				_, _, calories, err := dayActivity(data, weight, height)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("неделя %d, день %d, запись %d: %w", w+1, d+1, r+1, err))
+					continue
+				}
+
+				total += calories
+			}
+		}
+	}
+
+	return total, errs
+}
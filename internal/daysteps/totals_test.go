@@ -0,0 +1,30 @@
+package daysteps
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestMonthlyTotal() {
+	weeks := [][][]string{
+		{
+			{"6000,1h00m", "3000,30m"},
+			{"1000,2h00m"},
+		},
+		{
+			{"20000,1h00m", "abc,1h00m"},
+			{},
+			{"0,1h00m"},
+		},
+	}
+
+	got, errs := MonthlyTotal(weeks, 75.0, 1.75)
+
+	assert.InDelta(suite.T(), 885.94, got, 0.01)
+	assert.Len(suite.T(), errs, 2)
+	assert.ErrorContains(suite.T(), errs[0], "неделя 2, день 1, запись 2")
+	assert.ErrorContains(suite.T(), errs[1], "неделя 2, день 3, запись 1")
+
+	got, errs = MonthlyTotal(nil, 75.0, 1.75)
+	assert.Equal(suite.T(), 0.0, got)
+	assert.Empty(suite.T(), errs)
+}
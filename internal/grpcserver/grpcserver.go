@@ -0,0 +1,169 @@
+// Package grpcserver реализует сервис Tracker из api/tracker/v1/tracker.proto
+// поверх пакетов spentcalories, daysteps и history.
+//
+// Service не зависит от сгенерированного кода: его методы принимают и возвращают
+// структуры, поля которых совпадают с сообщениями proto, а Server реализует
+// trackerpb.TrackerServer и лишь переводит сообщения в эти структуры и обратно.
+// Ошибки входных данных оборачивают ErrInvalidArgument и возвращаются
+// клиенту с кодом codes.InvalidArgument.
+package grpcserver
+
+//go:generate protoc -I ../../api --go_out=. --go_opt=module=github.com/Yandex-Practicum/tracker/internal/grpcserver --go-grpc_out=. --go-grpc_opt=module=github.com/Yandex-Practicum/tracker/internal/grpcserver tracker/v1/tracker.proto
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Yandex-Practicum/tracker/internal/daysteps"
+	"github.com/Yandex-Practicum/tracker/internal/history"
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+// DateLayout — формат даты в SummaryRequest.
+const DateLayout = "2006-01-02"
+
+// ErrInvalidArgument — ошибка во входных данных запроса.
+var ErrInvalidArgument = errors.New("неверные входные данные")
+
+// TrainingRecord соответствует сообщению tracker.v1.TrainingRecord.
+type TrainingRecord struct {
+	Date     time.Time     // дата тренировки; нулевая означает текущее время.
+	Steps    int64         // количество шагов; обороты педалей для велосипеда.
+	Activity string        // вид активности.
+	Duration time.Duration // продолжительность тренировки.
+}
+
+// DayPackage соответствует сообщению tracker.v1.DayPackage.
+type DayPackage struct {
+	Date     time.Time     // дата записи; нулевая означает текущее время.
+	Steps    int64         // количество шагов.
+	Duration time.Duration // длительность активности.
+}
+
+// Training соответствует сообщению tracker.v1.Training.
+type Training struct {
+	Date       time.Time
+	Activity   string
+	Steps      int64
+	Duration   time.Duration
+	DistanceKm float64
+	SpeedKmh   float64
+	Calories   float64
+}
+
+// Summary соответствует сообщению tracker.v1.Summary.
+type Summary struct {
+	Steps      int64
+	Duration   time.Duration
+	DistanceKm float64
+	Calories   float64
+}
+
+// Service — реализация сервиса Tracker для одного пользователя.
+// Методы безопасно вызывать из нескольких горутин.
+type Service struct {
+	history *history.History
+	weight  float64
+	height  float64
+	loc     *time.Location
+	now     func() time.Time
+}
+
+// New создаёт сервис для пользователя с заданным весом в килограммах и ростом в метрах.
+// Границы дней в GetSummary определяются в часовом поясе loc.
+func New(weight, height float64, loc *time.Location) (*Service, error) {
+	h, err := history.New(weight, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Service{history: h, weight: weight, height: height, loc: loc, now: time.Now}, nil
+}
+
+// AddTraining рассчитывает и сохраняет тренировку.
+func (s *Service) AddTraining(ctx context.Context, req TrainingRecord) (Training, error) {
+	if err := ctx.Err(); err != nil {
+		return Training{}, err
+	}
+
+	t, err := spentcalories.CalculateTraining(int(req.Steps), req.Activity, req.Duration, s.weight, s.height)
+	if err != nil {
+		return Training{}, invalidArgument(err)
+	}
+
+	date := s.date(req.Date)
+	s.history.AddTrainingEntry(spentcalories.TrainingEntry{
+		Date:     date,
+		Steps:    t.Steps,
+		Activity: t.Activity,
+		Duration: t.Duration,
+		Distance: t.Distance,
+		Calories: t.Calories,
+	})
+
+	return Training{
+		Date:       date,
+		Activity:   t.Activity,
+		Steps:      int64(t.Steps),
+		Duration:   t.Duration,
+		DistanceKm: t.Distance,
+		SpeedKmh:   t.Speed,
+		Calories:   t.Calories,
+	}, nil
+}
+
+// AddDayPackage рассчитывает и сохраняет запись дневной активности.
+func (s *Service) AddDayPackage(ctx context.Context, req DayPackage) (Summary, error) {
+	if err := ctx.Err(); err != nil {
+		return Summary{}, err
+	}
+
+	summary, err := daysteps.CalculateDay(int(req.Steps), req.Duration, s.weight, s.height)
+	if err != nil {
+		return Summary{}, invalidArgument(err)
+	}
+
+	s.history.AddDaySummary(s.date(req.Date), summary)
+
+	return Summary{
+		Steps:      int64(summary.Steps),
+		Duration:   summary.Duration,
+		DistanceKm: summary.Distance,
+		Calories:   summary.Calories,
+	}, nil
+}
+
+// GetSummary возвращает суммарные показатели за день в формате DateLayout.
+func (s *Service) GetSummary(ctx context.Context, date string) (Summary, error) {
+	if err := ctx.Err(); err != nil {
+		return Summary{}, err
+	}
+
+	day, err := time.ParseInLocation(DateLayout, date, s.loc)
+	if err != nil {
+		return Summary{}, invalidArgument(fmt.Errorf("неверный формат даты, ожидается ГГГГ-ММ-ДД: %w", err))
+	}
+
+	report := s.history.ReportDay(day)
+
+	return Summary{
+		Steps:      int64(report.Steps),
+		Duration:   report.Duration,
+		DistanceKm: report.Distance,
+		Calories:   report.Calories,
+	}, nil
+}
+
+// date возвращает дату записи в часовом поясе сервиса; нулевая дата заменяется текущим временем.
+func (s *Service) date(t time.Time) time.Time {
+	if t.IsZero() {
+		t = s.now()
+	}
+	return t.In(s.loc)
+}
+
+func invalidArgument(err error) error {
+	return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
+}
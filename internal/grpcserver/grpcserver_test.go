@@ -0,0 +1,99 @@
+package grpcserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+type GRPCServerTestSuite struct {
+	suite.Suite
+	service *Service
+}
+
+func TestGRPCServerSuite(t *testing.T) {
+	suite.Run(t, new(GRPCServerTestSuite))
+}
+
+func (suite *GRPCServerTestSuite) SetupTest() {
+	s, err := New(75.0, 1.75, time.UTC)
+	suite.Require().NoError(err)
+	s.now = func() time.Time { return time.Date(2025, time.January, 14, 12, 0, 0, 0, time.UTC) }
+
+	suite.service = s
+}
+
+func (suite *GRPCServerTestSuite) TestNew() {
+	_, err := New(0, 1.75, time.UTC)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *GRPCServerTestSuite) TestAddTraining() {
+	got, err := suite.service.AddTraining(context.Background(), TrainingRecord{Steps: 6000, Activity: "Бег", Duration: time.Hour})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), Training{
+		Date:       time.Date(2025, time.January, 14, 12, 0, 0, 0, time.UTC),
+		Activity:   "Бег",
+		Steps:      6000,
+		Duration:   time.Hour,
+		DistanceKm: 4.725,
+		SpeedKmh:   4.725,
+		Calories:   354.375,
+	}, got)
+
+	_, err = suite.service.AddTraining(context.Background(), TrainingRecord{Steps: 6000, Activity: "Плавание", Duration: time.Hour})
+	assert.ErrorIs(suite.T(), err, ErrInvalidArgument)
+	assert.ErrorIs(suite.T(), err, spentcalories.ErrUnknownActivity)
+
+	_, err = suite.service.AddTraining(context.Background(), TrainingRecord{Activity: "Бег", Duration: time.Hour})
+	assert.ErrorIs(suite.T(), err, ErrInvalidArgument)
+}
+
+func (suite *GRPCServerTestSuite) TestAddDayPackage() {
+	got, err := suite.service.AddDayPackage(context.Background(), DayPackage{Steps: 6000, Duration: time.Hour})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(6000), got.Steps)
+	assert.InDelta(suite.T(), 3.9, got.DistanceKm, 1e-9)
+	assert.InDelta(suite.T(), 177.1875, got.Calories, 1e-9)
+
+	_, err = suite.service.AddDayPackage(context.Background(), DayPackage{Steps: 6000})
+	assert.ErrorIs(suite.T(), err, ErrInvalidArgument)
+	assert.ErrorIs(suite.T(), err, spentcalories.ErrNonPositiveDuration)
+}
+
+func (suite *GRPCServerTestSuite) TestGetSummary() {
+	ctx := context.Background()
+
+	_, err := suite.service.AddDayPackage(ctx, DayPackage{Date: time.Date(2025, time.January, 14, 8, 0, 0, 0, time.UTC), Steps: 6000, Duration: time.Hour})
+	assert.NoError(suite.T(), err)
+	_, err = suite.service.AddTraining(ctx, TrainingRecord{Date: time.Date(2025, time.January, 14, 18, 0, 0, 0, time.UTC), Steps: 6000, Activity: "Бег", Duration: time.Hour})
+	assert.NoError(suite.T(), err)
+	_, err = suite.service.AddDayPackage(ctx, DayPackage{Date: time.Date(2025, time.January, 15, 8, 0, 0, 0, time.UTC), Steps: 3000, Duration: 30 * time.Minute})
+	assert.NoError(suite.T(), err)
+
+	got, err := suite.service.GetSummary(ctx, "2025-01-14")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(12000), got.Steps)
+	assert.Equal(suite.T(), 2*time.Hour, got.Duration)
+	assert.InDelta(suite.T(), 3.9+4.725, got.DistanceKm, 1e-9)
+	assert.InDelta(suite.T(), 177.1875+354.375, got.Calories, 1e-9)
+
+	_, err = suite.service.GetSummary(ctx, "14.01.2025")
+	assert.ErrorIs(suite.T(), err, ErrInvalidArgument)
+}
+
+func (suite *GRPCServerTestSuite) TestCanceledContext() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := suite.service.AddTraining(ctx, TrainingRecord{Steps: 6000, Activity: "Бег", Duration: time.Hour})
+	assert.ErrorIs(suite.T(), err, context.Canceled)
+
+	_, err = suite.service.GetSummary(ctx, "2025-01-14")
+	assert.ErrorIs(suite.T(), err, context.Canceled)
+}
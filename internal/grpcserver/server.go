@@ -0,0 +1,141 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Yandex-Practicum/tracker/internal/grpcserver/trackerpb"
+)
+
+// Server реализует trackerpb.TrackerServer поверх Service.
+type Server struct {
+	trackerpb.UnimplementedTrackerServer
+	service *Service
+}
+
+// NewServer создаёт обработчики gRPC для сервиса.
+func NewServer(service *Service) *Server {
+	return &Server{service: service}
+}
+
+// NewGRPCServer создаёт gRPC-сервер с зарегистрированным сервисом Tracker.
+func NewGRPCServer(service *Service, opts ...grpc.ServerOption) *grpc.Server {
+	s := grpc.NewServer(opts...)
+	trackerpb.RegisterTrackerServer(s, NewServer(service))
+	return s
+}
+
+// AddTraining рассчитывает и сохраняет тренировку.
+func (s *Server) AddTraining(ctx context.Context, req *trackerpb.TrainingRecord) (*trackerpb.Training, error) {
+	date, err := fromTimestamp(req.GetDate())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	duration, err := fromDuration(req.GetDuration())
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	t, err := s.service.AddTraining(ctx, TrainingRecord{
+		Date:     date,
+		Steps:    req.GetSteps(),
+		Activity: req.GetActivity(),
+		Duration: duration,
+	})
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &trackerpb.Training{
+		Date:       timestamppb.New(t.Date),
+		Activity:   t.Activity,
+		Steps:      t.Steps,
+		Duration:   durationpb.New(t.Duration),
+		DistanceKm: t.DistanceKm,
+		SpeedKmh:   t.SpeedKmh,
+		Calories:   t.Calories,
+	}, nil
+}
+
+// AddDayPackage рассчитывает и сохраняет запись дневной активности.
+func (s *Server) AddDayPackage(ctx context.Context, req *trackerpb.DayPackage) (*trackerpb.Summary, error) {
+	date, err := fromTimestamp(req.GetDate())
+	if err != nil {
+		return nil, statusError(err)
+	}
+	duration, err := fromDuration(req.GetDuration())
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	summary, err := s.service.AddDayPackage(ctx, DayPackage{
+		Date:     date,
+		Steps:    req.GetSteps(),
+		Duration: duration,
+	})
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return toSummary(summary), nil
+}
+
+// GetSummary возвращает суммарные показатели за календарный день.
+func (s *Server) GetSummary(ctx context.Context, req *trackerpb.SummaryRequest) (*trackerpb.Summary, error) {
+	summary, err := s.service.GetSummary(ctx, req.GetDate())
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return toSummary(summary), nil
+}
+
+func toSummary(s Summary) *trackerpb.Summary {
+	return &trackerpb.Summary{
+		Steps:      s.Steps,
+		Duration:   durationpb.New(s.Duration),
+		DistanceKm: s.DistanceKm,
+		Calories:   s.Calories,
+	}
+}
+
+// fromTimestamp переводит необязательную дату запроса во время; nil означает нулевую дату.
+func fromTimestamp(ts *timestamppb.Timestamp) (time.Time, error) {
+	if ts == nil {
+		return time.Time{}, nil
+	}
+	if err := ts.CheckValid(); err != nil {
+		return time.Time{}, invalidArgument(err)
+	}
+	return ts.AsTime(), nil
+}
+
+// fromDuration переводит длительность запроса; nil означает нулевую длительность.
+func fromDuration(d *durationpb.Duration) (time.Duration, error) {
+	if d == nil {
+		return 0, nil
+	}
+	if err := d.CheckValid(); err != nil {
+		return 0, invalidArgument(err)
+	}
+	return d.AsDuration(), nil
+}
+
+// statusError переводит ошибку сервиса в статус gRPC.
+func statusError(err error) error {
+	switch {
+	case errors.Is(err, ErrInvalidArgument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
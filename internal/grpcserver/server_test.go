@@ -0,0 +1,115 @@
+package grpcserver
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Yandex-Practicum/tracker/internal/grpcserver/trackerpb"
+)
+
+// client запускает gRPC-сервер сервиса в памяти и возвращает подключенного к нему клиента.
+func (suite *GRPCServerTestSuite) client() trackerpb.TrackerClient {
+	lis := bufconn.Listen(1 << 20)
+	srv := NewGRPCServer(suite.service)
+	go func() { _ = srv.Serve(lis) }()
+	suite.T().Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	suite.Require().NoError(err)
+	suite.T().Cleanup(func() { _ = conn.Close() })
+
+	return trackerpb.NewTrackerClient(conn)
+}
+
+func (suite *GRPCServerTestSuite) TestGRPCEndToEnd() {
+	ctx := context.Background()
+	client := suite.client()
+
+	training, err := client.AddTraining(ctx, &trackerpb.TrainingRecord{
+		Date:     timestamppb.New(time.Date(2025, time.January, 14, 18, 0, 0, 0, time.UTC)),
+		Steps:    6000,
+		Activity: "Бег",
+		Duration: durationpb.New(time.Hour),
+	})
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), "Бег", training.GetActivity())
+	assert.Equal(suite.T(), int64(6000), training.GetSteps())
+	assert.Equal(suite.T(), time.Hour, training.GetDuration().AsDuration())
+	assert.InDelta(suite.T(), 4.725, training.GetDistanceKm(), 1e-9)
+	assert.InDelta(suite.T(), 4.725, training.GetSpeedKmh(), 1e-9)
+	assert.InDelta(suite.T(), 354.375, training.GetCalories(), 1e-9)
+
+	// Без даты используется время сервера
+	day, err := client.AddDayPackage(ctx, &trackerpb.DayPackage{Steps: 6000, Duration: durationpb.New(time.Hour)})
+	suite.Require().NoError(err)
+	assert.InDelta(suite.T(), 3.9, day.GetDistanceKm(), 1e-9)
+	assert.InDelta(suite.T(), 177.1875, day.GetCalories(), 1e-9)
+
+	summary, err := client.GetSummary(ctx, &trackerpb.SummaryRequest{Date: "2025-01-14"})
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), int64(12000), summary.GetSteps())
+	assert.Equal(suite.T(), 2*time.Hour, summary.GetDuration().AsDuration())
+	assert.InDelta(suite.T(), 3.9+4.725, summary.GetDistanceKm(), 1e-9)
+	assert.InDelta(suite.T(), 177.1875+354.375, summary.GetCalories(), 1e-9)
+}
+
+func (suite *GRPCServerTestSuite) TestGRPCErrors() {
+	ctx := context.Background()
+	client := suite.client()
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{
+			name: "неизвестная активность",
+			call: func() error {
+				_, err := client.AddTraining(ctx, &trackerpb.TrainingRecord{Steps: 6000, Activity: "Плавание", Duration: durationpb.New(time.Hour)})
+				return err
+			},
+		},
+		{
+			name: "нет длительности",
+			call: func() error {
+				_, err := client.AddDayPackage(ctx, &trackerpb.DayPackage{Steps: 6000})
+				return err
+			},
+		},
+		{
+			name: "некорректная длительность",
+			call: func() error {
+				_, err := client.AddDayPackage(ctx, &trackerpb.DayPackage{Steps: 6000, Duration: &durationpb.Duration{Seconds: 1, Nanos: -1}})
+				return err
+			},
+		},
+		{
+			name: "неверный формат даты",
+			call: func() error {
+				_, err := client.GetSummary(ctx, &trackerpb.SummaryRequest{Date: "14.01.2025"})
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			err := tt.call()
+			assert.Equal(suite.T(), codes.InvalidArgument, status.Code(err), "ошибка: %v", err)
+		})
+	}
+}
@@ -0,0 +1,209 @@
+// Package history хранит разобранные записи тренировок и дневной активности с датами
+// и формирует по ним недельные и месячные отчёты.
+package history
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Yandex-Practicum/tracker/internal/daysteps"
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+// dayRecord — запись дневной активности с рассчитанными показателями.
+type dayRecord struct {
+	date    time.Time
+	summary daysteps.DaySummary
+}
+
+// History — журнал тренировок и дневной активности одного пользователя.
+// Методы безопасно вызывать из нескольких горутин.
+type History struct {
+	mu        sync.RWMutex
+	weight    float64
+	height    float64
+	trainings []spentcalories.TrainingEntry
+	days      []dayRecord
+}
+
+// New создаёт пустой журнал для пользователя с заданным весом в килограммах и ростом в метрах.
+func New(weight, height float64) (*History, error) {
+	if weight <= 0 {
+		return nil, fmt.Errorf("вес должен быть больше 0")
+	}
+	if height <= 0 {
+		return nil, fmt.Errorf("рост должен быть больше 0")
+	}
+
+	return &History{weight: weight, height: height}, nil
+}
+
+// AddTraining разбирает запись тренировки "шаги,активность,длительность" и сохраняет её с датой.
+func (h *History) AddTraining(date time.Time, data string) error {
+	entry, err := spentcalories.NewTrainingEntry(date, data, h.weight, h.height)
+	if err != nil {
+		return err
+	}
+
+	h.AddTrainingEntry(entry)
+	return nil
+}
+
+// AddTrainingEntry сохраняет уже рассчитанную тренировку.
+func (h *History) AddTrainingEntry(entry spentcalories.TrainingEntry) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.trainings = append(h.trainings, entry)
+}
+
+// AddDay разбирает запись дневной активности "шаги,длительность" и сохраняет её с датой.
+func (h *History) AddDay(date time.Time, data string) error {
+	summary, err := daysteps.DayActionInfoE(data, h.weight, h.height)
+	if err != nil {
+		return err
+	}
+
+	h.AddDaySummary(date, summary)
+	return nil
+}
+
+// AddDaySummary сохраняет уже рассчитанную дневную активность.
+func (h *History) AddDaySummary(date time.Time, summary daysteps.DaySummary) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.days = append(h.days, dayRecord{date: date, summary: summary})
+}
+
+// Totals — суммарные показатели за период.
+type Totals struct {
+	Steps    int           // шаги дневной активности и тренировок с шагами.
+	Distance float64       // дистанция в километрах.
+	Calories float64       // калории.
+	Duration time.Duration // продолжительность активности.
+}
+
+// Report — отчёт за неделю или месяц со сравнением с предыдущим периодом.
+type Report struct {
+	Start time.Time // начало периода включительно.
+	End   time.Time // конец периода, не включая его.
+	Totals
+	Previous        Totals    // показатели предыдущего периода той же длины.
+	MostActiveDay   time.Time // начало дня с наибольшим количеством шагов; нулевое, если шагов не было.
+	MostActiveSteps int       // количество шагов в самый активный день.
+}
+
+// StepsChange возвращает изменение количества шагов относительно предыдущего периода в процентах.
+// Если в предыдущем периоде шагов не было, возвращается false.
+func (r Report) StepsChange() (float64, bool) {
+	return percentChange(float64(r.Steps), float64(r.Previous.Steps))
+}
+
+// CaloriesChange возвращает изменение калорий относительно предыдущего периода в процентах.
+// Если в предыдущем периоде калорий не было, возвращается false.
+func (r Report) CaloriesChange() (float64, bool) {
+	return percentChange(r.Calories, r.Previous.Calories)
+}
+
+func percentChange(current, previous float64) (float64, bool) {
+	if previous <= 0 {
+		return 0, false
+	}
+	return (current - previous) / previous * 100, true
+}
+
+// ReportDay формирует отчёт за календарный день, в который попадает t,
+// со сравнением с предыдущим днём. Границы дня определяются в часовом поясе t.
+func (h *History) ReportDay(t time.Time) Report {
+	start := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+
+	return h.report(start, start.AddDate(0, 0, 1), start.AddDate(0, 0, -1))
+}
+
+// ReportWeek формирует отчёт за ISO-неделю (с понедельника), в которую попадает t.
+// Границы дней определяются в часовом поясе t.
+func (h *History) ReportWeek(t time.Time) Report {
+	// Неделя по ISO начинается с понедельника
+	offset := (int(t.Weekday()) + 6) % 7
+	start := time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, t.Location())
+
+	return h.report(start, start.AddDate(0, 0, 7), start.AddDate(0, 0, -7))
+}
+
+// ReportMonth формирует отчёт за календарный месяц, в который попадает t.
+// Границы дней определяются в часовом поясе t.
+func (h *History) ReportMonth(t time.Time) Report {
+	start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+
+	return h.report(start, start.AddDate(0, 1, 0), start.AddDate(0, -1, 0))
+}
+
+func (h *History) report(start, end, previousStart time.Time) Report {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	report := Report{
+		Start:    start,
+		End:      end,
+		Totals:   h.totals(start, end),
+		Previous: h.totals(previousStart, start),
+	}
+
+	// Суммируем шаги по календарным дням, чтобы найти самый активный
+	loc := start.Location()
+	stepsByDay := make(map[time.Time]int)
+	h.each(start, end, func(date time.Time, steps int, _ Totals) {
+		d := date.In(loc)
+		stepsByDay[time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, loc)] += steps
+	})
+
+	for day, steps := range stepsByDay {
+		if steps > report.MostActiveSteps || (steps == report.MostActiveSteps && steps > 0 && day.Before(report.MostActiveDay)) {
+			report.MostActiveDay = day
+			report.MostActiveSteps = steps
+		}
+	}
+
+	return report
+}
+
+func (h *History) totals(start, end time.Time) Totals {
+	var totals Totals
+
+	h.each(start, end, func(_ time.Time, steps int, t Totals) {
+		totals.Steps += steps
+		totals.Distance += t.Distance
+		totals.Calories += t.Calories
+		totals.Duration += t.Duration
+	})
+
+	return totals
+}
+
+// each вызывает fn для каждой записи с датой в интервале [start, end).
+// Шаги тренировок без шагов, например велосипеда, не учитываются.
+func (h *History) each(start, end time.Time, fn func(date time.Time, steps int, t Totals)) {
+	inPeriod := func(date time.Time) bool {
+		return !date.Before(start) && date.Before(end)
+	}
+
+	for _, d := range h.days {
+		if inPeriod(d.date) {
+			fn(d.date, d.summary.Steps, Totals{Distance: d.summary.Distance, Calories: d.summary.Calories, Duration: d.summary.Duration})
+		}
+	}
+
+	for _, e := range h.trainings {
+		if !inPeriod(e.Date) {
+			continue
+		}
+
+		steps := 0
+		if spentcalories.IsStepActivity(e.Activity) {
+			steps = e.Steps
+		}
+		fn(e.Date, steps, Totals{Distance: e.Distance, Calories: e.Calories, Duration: e.Duration})
+	}
+}
@@ -0,0 +1,127 @@
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type HistoryTestSuite struct {
+	suite.Suite
+}
+
+func TestHistorySuite(t *testing.T) {
+	suite.Run(t, new(HistoryTestSuite))
+}
+
+func date(month time.Month, day, hour int) time.Time {
+	return time.Date(2025, month, day, hour, 0, 0, 0, time.UTC)
+}
+
+func (suite *HistoryTestSuite) newHistory() *History {
+	h, err := New(75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	// Предыдущий месяц и предыдущая неделя
+	assert.NoError(suite.T(), h.AddDay(time.Date(2024, time.December, 20, 9, 0, 0, 0, time.UTC), "10000,2h00m"))
+	assert.NoError(suite.T(), h.AddDay(date(time.January, 8, 8), "6000,1h00m"))
+
+	// Неделя с понедельника 13 января
+	assert.NoError(suite.T(), h.AddDay(date(time.January, 13, 8), "6000,1h00m"))
+	assert.NoError(suite.T(), h.AddDay(date(time.January, 14, 8), "3000,30m"))
+	assert.NoError(suite.T(), h.AddTraining(date(time.January, 14, 18), "6000,Бег,1h00m"))
+	assert.NoError(suite.T(), h.AddTraining(date(time.January, 15, 18), "1500,Велосипед,20m"))
+
+	return h
+}
+
+func (suite *HistoryTestSuite) TestNew() {
+	_, err := New(0, 1.75)
+	assert.Error(suite.T(), err)
+
+	_, err = New(75.0, 0)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *HistoryTestSuite) TestAddInvalid() {
+	h := suite.newHistory()
+
+	assert.Error(suite.T(), h.AddDay(date(time.January, 16, 8), "abc,1h00m"))
+	assert.Error(suite.T(), h.AddTraining(date(time.January, 16, 8), "6000,Плавание,1h00m"))
+
+	// Некорректные записи не попадают в отчёт
+	report := h.ReportWeek(date(time.January, 16, 12))
+	assert.Equal(suite.T(), 15000, report.Steps)
+}
+
+func (suite *HistoryTestSuite) TestReportWeek() {
+	h := suite.newHistory()
+
+	report := h.ReportWeek(date(time.January, 17, 12))
+
+	assert.Equal(suite.T(), date(time.January, 13, 0), report.Start)
+	assert.Equal(suite.T(), date(time.January, 20, 0), report.End)
+
+	// Обороты педалей не считаются шагами
+	assert.Equal(suite.T(), 15000, report.Steps)
+	assert.InDelta(suite.T(), 3.9+1.95+4.725+9, report.Distance, 1e-9)
+	assert.InDelta(suite.T(), 177.1875+88.59375+354.375+300, report.Calories, 1e-9)
+	assert.Equal(suite.T(), 2*time.Hour+50*time.Minute, report.Duration)
+
+	assert.Equal(suite.T(), date(time.January, 14, 0), report.MostActiveDay)
+	assert.Equal(suite.T(), 9000, report.MostActiveSteps)
+
+	assert.Equal(suite.T(), 6000, report.Previous.Steps)
+	change, ok := report.StepsChange()
+	assert.True(suite.T(), ok)
+	assert.InDelta(suite.T(), 150, change, 1e-9)
+
+	change, ok = report.CaloriesChange()
+	assert.True(suite.T(), ok)
+	assert.InDelta(suite.T(), (920.15625-177.1875)/177.1875*100, change, 1e-9)
+}
+
+func (suite *HistoryTestSuite) TestReportDay() {
+	h := suite.newHistory()
+
+	report := h.ReportDay(date(time.January, 14, 12))
+
+	assert.Equal(suite.T(), date(time.January, 14, 0), report.Start)
+	assert.Equal(suite.T(), date(time.January, 15, 0), report.End)
+	assert.Equal(suite.T(), 9000, report.Steps)
+	assert.InDelta(suite.T(), 88.59375+354.375, report.Calories, 1e-9)
+	assert.Equal(suite.T(), 90*time.Minute, report.Duration)
+	assert.Equal(suite.T(), 6000, report.Previous.Steps)
+	assert.Equal(suite.T(), date(time.January, 14, 0), report.MostActiveDay)
+}
+
+func (suite *HistoryTestSuite) TestReportMonth() {
+	h := suite.newHistory()
+
+	report := h.ReportMonth(date(time.January, 31, 23))
+
+	assert.Equal(suite.T(), date(time.January, 1, 0), report.Start)
+	assert.Equal(suite.T(), date(time.February, 1, 0), report.End)
+	assert.Equal(suite.T(), 21000, report.Steps)
+	assert.Equal(suite.T(), 10000, report.Previous.Steps)
+	assert.Equal(suite.T(), date(time.January, 14, 0), report.MostActiveDay)
+
+	change, ok := report.StepsChange()
+	assert.True(suite.T(), ok)
+	assert.InDelta(suite.T(), 110, change, 1e-9)
+}
+
+func (suite *HistoryTestSuite) TestReportEmptyPeriod() {
+	h := suite.newHistory()
+
+	report := h.ReportWeek(date(time.March, 3, 12))
+
+	assert.Equal(suite.T(), Totals{}, report.Totals)
+	assert.True(suite.T(), report.MostActiveDay.IsZero())
+	assert.Equal(suite.T(), 0, report.MostActiveSteps)
+
+	_, ok := report.StepsChange()
+	assert.False(suite.T(), ok)
+}
@@ -0,0 +1,177 @@
+// Package gpx импортирует треки в формате GPX, например с GPS-часов, и рассчитывает
+// по ним тренировку: дистанцию и длительность по точкам трека, вид активности
+// по средней скорости и калории по калькуляторам spentcalories.
+package gpx
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+// Границы средней скорости в км/ч для определения вида активности.
+const (
+	maxWalkingSpeed = 7.0  // ниже этой скорости трек считается ходьбой.
+	maxRunningSpeed = 16.0 // ниже этой скорости трек считается бегом, выше — велосипедом.
+)
+
+// Названия видов активности, определяемых по скорости.
+const (
+	ActivityWalking = "Ходьба"
+	ActivityRunning = "Бег"
+	ActivityCycling = "Велосипед"
+)
+
+// ErrNoTrackPoints — в файле меньше двух точек трека со временем.
+var ErrNoTrackPoints = errors.New("в треке недостаточно точек со временем")
+
+// gpxFile — элементы GPX-файла, необходимые для расчета.
+type gpxFile struct {
+	Tracks []struct {
+		Name     string `xml:"name"`
+		Segments []struct {
+			Points []trackPoint `xml:"trkpt"`
+		} `xml:"trkseg"`
+	} `xml:"trk"`
+}
+
+// trackPoint — точка трека.
+type trackPoint struct {
+	Lat  float64   `xml:"lat,attr"`
+	Lon  float64   `xml:"lon,attr"`
+	Time time.Time `xml:"time"`
+}
+
+// point переводит точку трека в точку маршрута spentcalories.
+func (p trackPoint) point() spentcalories.TrackPoint {
+	return spentcalories.TrackPoint{Lat: p.Lat, Lon: p.Lon, Time: p.Time}
+}
+
+// Track — трек с дистанцией и длительностью, рассчитанными по точкам.
+type Track struct {
+	Name     string        // название первого трека в файле.
+	Start    time.Time     // время первой точки.
+	Points   int           // количество точек.
+	Distance float64       // дистанция в километрах.
+	Duration time.Duration // время движения: сумма длительностей сегментов без пауз между ними.
+}
+
+// Parse читает GPX-файл и рассчитывает дистанцию и длительность по точкам всех треков.
+// Паузы между сегментами трека не учитываются ни в дистанции, ни в длительности.
+func Parse(r io.Reader) (Track, error) {
+	var file gpxFile
+	if err := xml.NewDecoder(r).Decode(&file); err != nil {
+		return Track{}, fmt.Errorf("неверный формат GPX: %w", err)
+	}
+
+	var track Track
+	for _, trk := range file.Tracks {
+		if track.Name == "" {
+			track.Name = trk.Name
+		}
+
+		for _, seg := range trk.Segments {
+			if err := track.addSegment(seg.Points); err != nil {
+				return Track{}, err
+			}
+		}
+	}
+
+	if track.Points < 2 || track.Duration <= 0 {
+		return Track{}, ErrNoTrackPoints
+	}
+
+	return track, nil
+}
+
+// addSegment добавляет к треку дистанцию и длительность сегмента.
+func (t *Track) addSegment(points []trackPoint) error {
+	for i, p := range points {
+		if p.Time.IsZero() {
+			return fmt.Errorf("у точки %d трека не указано время", t.Points+1)
+		}
+		if p.Lat < -90 || p.Lat > 90 || p.Lon < -180 || p.Lon > 180 {
+			return fmt.Errorf("у точки %d трека неверные координаты: %v, %v", t.Points+1, p.Lat, p.Lon)
+		}
+
+		if t.Start.IsZero() || p.Time.Before(t.Start) {
+			t.Start = p.Time
+		}
+		t.Points++
+
+		if i == 0 {
+			continue
+		}
+
+		prev := points[i-1]
+		if p.Time.Before(prev.Time) {
+			return fmt.Errorf("точки трека %d и %d идут не по порядку времени", t.Points-1, t.Points)
+		}
+
+		t.Distance += spentcalories.HaversineKm(prev.point(), p.point())
+		t.Duration += p.Time.Sub(prev.Time)
+	}
+
+	return nil
+}
+
+// Speed возвращает среднюю скорость в км/ч.
+func (t Track) Speed() float64 {
+	if t.Duration <= 0 {
+		return 0
+	}
+	return t.Distance / t.Duration.Hours()
+}
+
+// Activity определяет вид активности по средней скорости: до 7 км/ч — ходьба,
+// до 16 км/ч — бег, быстрее — велосипед.
+func (t Track) Activity() string {
+	switch speed := t.Speed(); {
+	case speed < maxWalkingSpeed:
+		return ActivityWalking
+	case speed < maxRunningSpeed:
+		return ActivityRunning
+	default:
+		return ActivityCycling
+	}
+}
+
+// Training рассчитывает тренировку по треку для пользователя с заданным весом
+// в килограммах и ростом в метрах. Дистанция переводится в шаги по росту,
+// а для велосипеда — в обороты педалей, после чего калории рассчитываются
+// так же, как для записи "шаги,активность,длительность".
+func (t Track) Training(weight, height float64) (spentcalories.TrainingEntry, error) {
+	activity := t.Activity()
+
+	steps, err := spentcalories.StepsForDistance(activity, t.Distance, height)
+	if err != nil {
+		return spentcalories.TrainingEntry{}, err
+	}
+	training, err := spentcalories.CalculateTraining(steps, activity, t.Duration, weight, height)
+	if err != nil {
+		return spentcalories.TrainingEntry{}, err
+	}
+
+	return spentcalories.TrainingEntry{
+		Date:     t.Start,
+		Steps:    training.Steps,
+		Activity: training.Activity,
+		Duration: training.Duration,
+		Distance: training.Distance,
+		Calories: training.Calories,
+	}, nil
+}
+
+// Import читает GPX-файл и рассчитывает по нему тренировку.
+func Import(r io.Reader, weight, height float64) (spentcalories.TrainingEntry, error) {
+	track, err := Parse(r)
+	if err != nil {
+		return spentcalories.TrainingEntry{}, err
+	}
+
+	return track.Training(weight, height)
+}
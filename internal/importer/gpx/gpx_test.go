@@ -0,0 +1,184 @@
+package gpx
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type GPXTestSuite struct {
+	suite.Suite
+}
+
+func TestGPXSuite(t *testing.T) {
+	suite.Run(t, new(GPXTestSuite))
+}
+
+// kmPerDegree — длина одного градуса меридиана в километрах при среднем радиусе Земли 6371 км.
+var kmPerDegree = 6371.0 * math.Pi / 180
+
+var start = time.Date(2025, time.January, 14, 8, 0, 0, 0, time.UTC)
+
+// point описывает точку на меридиане: сдвиг к северу в километрах и время от начала.
+type point struct {
+	km      float64
+	elapsed time.Duration
+}
+
+// gpxDoc формирует GPX-файл с одним треком; каждый срез точек — отдельный сегмент.
+func gpxDoc(segments ...[]point) string {
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8"?>
+<gpx version="1.1" creator="test" xmlns="http://www.topografix.com/GPX/1/1">
+<trk><name>Утренняя тренировка</name>`)
+
+	for _, seg := range segments {
+		b.WriteString("<trkseg>")
+		for _, p := range seg {
+			fmt.Fprintf(&b, `<trkpt lat="%.9f" lon="37.6"><ele>150</ele><time>%s</time></trkpt>`,
+				55.75+p.km/kmPerDegree, start.Add(p.elapsed).Format(time.RFC3339))
+		}
+		b.WriteString("</trkseg>")
+	}
+
+	b.WriteString("</trk></gpx>")
+	return b.String()
+}
+
+func (suite *GPXTestSuite) TestParse() {
+	doc := gpxDoc(
+		[]point{{0, 0}, {1, 10 * time.Minute}, {2, 20 * time.Minute}},
+		// Пауза 10 минут между сегментами не учитывается
+		[]point{{2, 30 * time.Minute}, {3, 40 * time.Minute}},
+	)
+
+	got, err := Parse(strings.NewReader(doc))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Утренняя тренировка", got.Name)
+	assert.Equal(suite.T(), start, got.Start)
+	assert.Equal(suite.T(), 5, got.Points)
+	assert.InDelta(suite.T(), 3, got.Distance, 1e-6)
+	assert.Equal(suite.T(), 30*time.Minute, got.Duration)
+	assert.InDelta(suite.T(), 6, got.Speed(), 1e-5)
+}
+
+func (suite *GPXTestSuite) TestActivity() {
+	tests := []struct {
+		name string
+		km   float64
+		want string
+	}{
+		{name: "ходьба", km: 5, want: ActivityWalking},
+		{name: "бег", km: 10, want: ActivityRunning},
+		{name: "велосипед", km: 25, want: ActivityCycling},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			track := Track{Distance: tt.km, Duration: time.Hour}
+			assert.Equal(suite.T(), tt.want, track.Activity())
+		})
+	}
+}
+
+func (suite *GPXTestSuite) TestImport() {
+	tests := []struct {
+		name         string
+		km           float64
+		wantActivity string
+		wantSteps    int
+		wantCalories float64
+	}{
+		{
+			name:         "ходьба",
+			km:           3.9,
+			wantActivity: ActivityWalking,
+			wantSteps:    4952,
+			wantCalories: 146.25,
+		},
+		{
+			name:         "бег",
+			km:           9,
+			wantActivity: ActivityRunning,
+			wantSteps:    11429,
+			wantCalories: 675,
+		},
+		{
+			name:         "велосипед",
+			km:           18,
+			wantActivity: ActivityCycling,
+			wantSteps:    3000,
+			wantCalories: 510,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			doc := gpxDoc([]point{{0, 0}, {tt.km / 2, 30 * time.Minute}, {tt.km, time.Hour}})
+
+			got, err := Import(strings.NewReader(doc), 75.0, 1.75)
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), start, got.Date)
+			assert.Equal(suite.T(), tt.wantActivity, got.Activity)
+			assert.Equal(suite.T(), tt.wantSteps, got.Steps)
+			assert.Equal(suite.T(), time.Hour, got.Duration)
+			assert.InDelta(suite.T(), tt.km, got.Distance, 1e-3)
+			assert.InDelta(suite.T(), tt.wantCalories, got.Calories, 0.5)
+		})
+	}
+}
+
+func (suite *GPXTestSuite) TestParseErrors() {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{
+			name:    "не XML",
+			input:   "steps,activity,duration",
+			wantErr: "неверный формат GPX",
+		},
+		{
+			name:    "нет треков",
+			input:   `<gpx version="1.1"></gpx>`,
+			wantErr: ErrNoTrackPoints.Error(),
+		},
+		{
+			name:    "одна точка",
+			input:   gpxDoc([]point{{0, 0}}),
+			wantErr: ErrNoTrackPoints.Error(),
+		},
+		{
+			name:    "точка без времени",
+			input:   `<gpx><trk><trkseg><trkpt lat="55.75" lon="37.6"></trkpt></trkseg></trk></gpx>`,
+			wantErr: "не указано время",
+		},
+		{
+			name:    "неверные координаты",
+			input:   `<gpx><trk><trkseg><trkpt lat="95" lon="37.6"><time>2025-01-14T08:00:00Z</time></trkpt></trkseg></trk></gpx>`,
+			wantErr: "неверные координаты",
+		},
+		{
+			name:    "время идёт назад",
+			input:   gpxDoc([]point{{0, 10 * time.Minute}, {1, 0}}),
+			wantErr: "не по порядку времени",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			_, err := Parse(strings.NewReader(tt.input))
+			assert.ErrorContains(suite.T(), err, tt.wantErr)
+		})
+	}
+
+	_, err := Parse(strings.NewReader(gpxDoc()))
+	assert.True(suite.T(), errors.Is(err, ErrNoTrackPoints))
+}
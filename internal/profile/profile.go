@@ -0,0 +1,126 @@
+// Package profile хранит параметры пользователя и позволяет вызывать расчеты
+// дневной активности и тренировок без передачи веса и роста в каждый вызов.
+package profile
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Yandex-Practicum/tracker/internal/daysteps"
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+	"github.com/Yandex-Practicum/tracker/internal/units"
+)
+
+// Profile — параметры пользователя для расчетов.
+// Вес, рост и длина шага указываются в единицах системы Units.
+type Profile struct {
+	Weight     float64           // вес в килограммах или фунтах.
+	Height     float64           // рост в метрах или дюймах.
+	Age        int               // возраст в годах; 0 — не указан.
+	Sex        spentcalories.Sex // пол; SexUnknown — не указан.
+	StepLength float64           // измеренная длина шага в метрах или дюймах; 0 — рассчитывается по росту.
+	Units      units.System      // система измерения веса, роста и длины шага; пустая строка означает метрическую.
+}
+
+// Validate проверяет параметры профиля.
+func (p Profile) Validate() error {
+	if p.Weight <= 0 {
+		return fmt.Errorf("вес должен быть больше 0")
+	}
+	if p.Height <= 0 {
+		return fmt.Errorf("рост должен быть больше 0")
+	}
+	if p.Age < 0 || p.Age > 120 {
+		return fmt.Errorf("возраст должен быть в диапазоне от 0 до 120 лет")
+	}
+	if p.StepLength < 0 {
+		return fmt.Errorf("длина шага не может быть отрицательной")
+	}
+	return p.Units.Validate()
+}
+
+// TrainingInfo работает как spentcalories.TrainingInfo с весом, ростом
+// и измеренной длиной шага из профиля.
+func (p Profile) TrainingInfo(data string) (string, error) {
+	t, err := p.NewTraining(data)
+	if err != nil {
+		return "", err
+	}
+
+	return t.String(), nil
+}
+
+// TrainingInfoWithHR работает как spentcalories.TrainingInfoWithHR с параметрами из профиля.
+// Для расчета калорий по пульсу в профиле должен быть указан возраст.
+func (p Profile) TrainingInfoWithHR(data string) (string, error) {
+	if err := p.Validate(); err != nil {
+		return "", err
+	}
+
+	t, err := spentcalories.NewTrainingWithHR(data, p.WeightKg(), p.HeightMeters(), p.StepLengthMeters(), p.Age, p.Sex)
+	if err != nil {
+		return "", err
+	}
+
+	return t.String(), nil
+}
+
+// DayActionInfo работает как daysteps.DayActionInfoWithStepLength с весом, ростом
+// и измеренной длиной шага из профиля.
+func (p Profile) DayActionInfo(data string) (string, error) {
+	if err := p.Validate(); err != nil {
+		return "", err
+	}
+
+	return daysteps.DayActionInfoWithStepLength(data, p.WeightKg(), p.HeightMeters(), p.StepLengthMeters())
+}
+
+// NewTraining работает как spentcalories.NewTraining с параметрами из профиля.
+func (p Profile) NewTraining(data string) (spentcalories.Training, error) {
+	if err := p.Validate(); err != nil {
+		return spentcalories.Training{}, err
+	}
+
+	return spentcalories.NewTrainingWithStepLength(data, p.WeightKg(), p.HeightMeters(), p.StepLengthMeters())
+}
+
+// NewTrainingEntry работает как spentcalories.NewTrainingEntry с параметрами из профиля.
+func (p Profile) NewTrainingEntry(date time.Time, data string) (spentcalories.TrainingEntry, error) {
+	t, err := p.NewTraining(data)
+	if err != nil {
+		return spentcalories.TrainingEntry{}, err
+	}
+
+	return spentcalories.TrainingEntry{
+		Date:     date,
+		Steps:    t.Steps,
+		Activity: t.Activity,
+		Duration: t.Duration,
+		Distance: t.Distance,
+		Calories: t.Calories,
+	}, nil
+}
+
+// CalculateDay работает как daysteps.CalculateDayWithStepLength с параметрами из профиля.
+func (p Profile) CalculateDay(steps int, duration time.Duration) (daysteps.DaySummary, error) {
+	if err := p.Validate(); err != nil {
+		return daysteps.DaySummary{}, err
+	}
+
+	return daysteps.CalculateDayWithStepLength(steps, duration, p.WeightKg(), p.HeightMeters(), p.StepLengthMeters())
+}
+
+// WeightKg возвращает вес в килограммах.
+func (p Profile) WeightKg() float64 {
+	return p.Units.WeightToKg(p.Weight)
+}
+
+// HeightMeters возвращает рост в метрах.
+func (p Profile) HeightMeters() float64 {
+	return p.Units.HeightToMeters(p.Height)
+}
+
+// StepLengthMeters возвращает измеренную длину шага в метрах; 0 — длина шага не измерена.
+func (p Profile) StepLengthMeters() float64 {
+	return p.Units.HeightToMeters(p.StepLength)
+}
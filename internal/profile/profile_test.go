@@ -0,0 +1,162 @@
+package profile
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+	"github.com/Yandex-Practicum/tracker/internal/units"
+)
+
+type ProfileTestSuite struct {
+	suite.Suite
+}
+
+func TestProfileSuite(t *testing.T) {
+	suite.Run(t, new(ProfileTestSuite))
+}
+
+func (suite *ProfileTestSuite) TestValidate() {
+	tests := []struct {
+		name    string
+		profile Profile
+		wantErr bool
+	}{
+		{
+			name:    "корректный профиль",
+			profile: Profile{Weight: 75.0, Height: 1.75, Age: 30, Sex: spentcalories.SexMale},
+			wantErr: false,
+		},
+		{
+			name:    "только вес и рост",
+			profile: Profile{Weight: 75.0, Height: 1.75},
+			wantErr: false,
+		},
+		{
+			name:    "нулевой вес",
+			profile: Profile{Height: 1.75},
+			wantErr: true,
+		},
+		{
+			name:    "нулевой рост",
+			profile: Profile{Weight: 75.0},
+			wantErr: true,
+		},
+		{
+			name:    "отрицательный возраст",
+			profile: Profile{Weight: 75.0, Height: 1.75, Age: -1},
+			wantErr: true,
+		},
+		{
+			name:    "отрицательная длина шага",
+			profile: Profile{Weight: 75.0, Height: 1.75, StepLength: -0.7},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			err := tt.profile.Validate()
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+		})
+	}
+}
+
+func (suite *ProfileTestSuite) TestTrainingInfo() {
+	p := Profile{Weight: 75.0, Height: 1.75}
+
+	got, err := p.TrainingInfo("6000,Бег,1h00m")
+	assert.NoError(suite.T(), err)
+
+	want, err := spentcalories.TrainingInfo("6000,Бег,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), want, got)
+
+	// Измеренная длина шага заменяет расчет по росту
+	measured := Profile{Weight: 75.0, Height: 1.90, StepLength: 1.75 * 0.45}
+	got, err = measured.TrainingInfo("6000,Бег,1h00m")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), want, got)
+
+	// Длинный шаг не должен проверяться как рост
+	got, err = Profile{Weight: 75.0, Height: 1.75, StepLength: 1.2}.TrainingInfo("6000,Бег,1h00m")
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Дистанция: 7.20 км.")
+
+	_, err = Profile{Height: 1.75}.TrainingInfo("6000,Бег,1h00m")
+	assert.Error(suite.T(), err)
+}
+
+func (suite *ProfileTestSuite) TestTrainingInfoWithHR() {
+	p := Profile{Weight: 75.0, Height: 1.75, Age: 30, Sex: spentcalories.SexMale}
+
+	got, err := p.TrainingInfoWithHR("6000,Бег,1h00m,150")
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Сожгли калорий: 867.58")
+
+	got, err = p.TrainingInfoWithHR("6000,Бег,1h00m")
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Сожгли калорий: 354.38")
+}
+
+func (suite *ProfileTestSuite) TestDayActionInfo() {
+	p := Profile{Weight: 75.0, Height: 1.75}
+
+	got, err := p.DayActionInfo("6000,1h00m")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Количество шагов: 6000.\nДистанция составила 4.72 км.\nВы сожгли 177.19 ккал.\n", got)
+
+	// Измеренная длина шага используется и для дневной дистанции
+	got, err = Profile{Weight: 75.0, Height: 1.75, StepLength: 0.9}.DayActionInfo("6000,1h00m")
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Дистанция составила 5.40 км.")
+
+	_, err = Profile{Weight: 75.0, Height: 1.75, StepLength: 1.2}.DayActionInfo("6000,1h00m")
+	assert.NoError(suite.T(), err)
+
+	_, err = p.DayActionInfo("6000")
+	assert.Error(suite.T(), err)
+
+	_, err = Profile{Weight: 75.0}.DayActionInfo("6000,1h00m")
+	assert.Error(suite.T(), err)
+}
+
+func (suite *ProfileTestSuite) TestCalculateDay() {
+	// Без измеренной длины шага дистанция считается по росту, как и калории
+	day, err := Profile{Weight: 75.0, Height: 1.75}.CalculateDay(6000, time.Hour)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 4.725, day.Distance, 1e-9)
+	assert.InDelta(suite.T(), 177.1875, day.Calories, 1e-9)
+
+	day, err = Profile{Weight: 75.0, Height: 1.75, StepLength: 0.9}.CalculateDay(6000, time.Hour)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 5.4, day.Distance, 1e-9)
+
+	entry, err := Profile{Weight: 75.0, Height: 1.75, StepLength: 0.9}.NewTrainingEntry(time.Time{}, "6000,Бег,1h00m")
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 5.4, entry.Distance, 1e-9)
+}
+
+func (suite *ProfileTestSuite) TestImperialUnits() {
+	metric := Profile{Weight: 165 * units.KgInLb, Height: 69 * units.MInIn}
+	imperial := Profile{Weight: 165, Height: 69, Units: units.Imperial}
+
+	want, err := metric.TrainingInfo("6000,Бег,1h00m")
+	assert.NoError(suite.T(), err)
+
+	got, err := imperial.TrainingInfo("6000,Бег,1h00m")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), want, got)
+
+	_, err = Profile{Weight: 75.0, Height: 1.75, Units: "nautical"}.TrainingInfo("6000,Бег,1h00m")
+	assert.Error(suite.T(), err)
+}
@@ -0,0 +1,44 @@
+package spentcalories
+
+import (
+	"sync"
+	"time"
+)
+
+// Accumulator накапливает суммарную дистанцию, калории и продолжительность тренировок.
+// Нулевое значение готово к использованию. Все методы безопасно вызывать
+// одновременно из нескольких горутин: каждое добавление учитывается целиком,
+// а Totals всегда возвращает согласованный снимок. Accumulator нельзя копировать
+// после первого использования.
+type Accumulator struct {
+	mu       sync.Mutex
+	distance float64
+	calories float64
+	duration time.Duration
+}
+
+// Add добавляет результат тренировки к накопленным итогам.
+func (a *Accumulator) Add(result TrainingResult) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.distance += result.Distance
+	a.calories += result.Calories
+	a.duration += result.Duration
+}
+
+// Totals возвращает суммарную дистанцию в километрах, калории и продолжительность.
+func (a *Accumulator) Totals() (distance, calories float64, duration time.Duration) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.distance, a.calories, a.duration
+}
+
+// Reset обнуляет накопленные итоги, например при переходе к новому отчётному периоду.
+func (a *Accumulator) Reset() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.distance, a.calories, a.duration = 0, 0, 0
+}
@@ -0,0 +1,41 @@
+package spentcalories
+
+import (
+	"sync"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestAccumulator() {
+	var acc Accumulator
+
+	result := TrainingResult{Distance: 2.5, Calories: 100, Duration: 15 * time.Minute}
+
+	// Добавляем результаты одновременно из нескольких горутин
+	const workers, perWorker = 8, 50
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range perWorker {
+				acc.Add(result)
+			}
+		}()
+	}
+	wg.Wait()
+
+	distance, calories, duration := acc.Totals()
+	assert.InDelta(suite.T(), 2.5*workers*perWorker, distance, 1e-9)
+	assert.InDelta(suite.T(), 100.0*workers*perWorker, calories, 1e-9)
+	assert.Equal(suite.T(), 15*time.Minute*workers*perWorker, duration)
+
+	acc.Reset()
+
+	distance, calories, duration = acc.Totals()
+	assert.Zero(suite.T(), distance)
+	assert.Zero(suite.T(), calories)
+	assert.Zero(suite.T(), duration)
+}
@@ -0,0 +1,126 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestNormalizeActivity() {
+	tests := []struct {
+		name      string
+		input     string
+		want      string
+		wantKind  string
+		wantKnown bool
+	}{
+		{
+			name:      "пробелы по краям",
+			input:     " Running ",
+			want:      "running",
+			wantKind:  activityRunning,
+			wantKnown: true,
+		},
+		{
+			name:      "верхний регистр",
+			input:     "БЕГ",
+			want:      "бег",
+			wantKind:  activityRunning,
+			wantKnown: true,
+		},
+		{
+			name:      "пробел в конце",
+			input:     "walk ",
+			want:      "walk",
+			wantKind:  activityWalking,
+			wantKnown: true,
+		},
+		{
+			name:      "табуляция и повторяющиеся пробелы",
+			input:     "\tнордическая   \t ходьба ",
+			want:      "нордическая ходьба",
+			wantKind:  "",
+			wantKnown: false,
+		},
+		{
+			name:      "точка в конце",
+			input:     "Бег.",
+			want:      "бег",
+			wantKind:  activityRunning,
+			wantKnown: true,
+		},
+		{
+			name:      "знаки препинания по краям",
+			input:     " (running!) ",
+			want:      "running",
+			wantKind:  activityRunning,
+			wantKnown: true,
+		},
+		{
+			name:      "пробел внутри слова",
+			input:     "run ning",
+			want:      "run ning",
+			wantKind:  "",
+			wantKnown: false,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.want, normalizeActivity(tt.input))
+
+			kind, ok := canonicalActivity(tt.input)
+			assert.Equal(suite.T(), tt.wantKind, kind)
+			assert.Equal(suite.T(), tt.wantKnown, ok)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestActivityPunctuationAndSpaces() {
+	want, err := NewTraining("6000,Бег,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	// Вид активности выводится как указан в записи, расчет — как для бега
+	got, err := NewTraining("6000,Бег.,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), want.Calories, got.Calories)
+	assert.Equal(suite.T(), want.Distance, got.Distance)
+
+	_, err = TrainingInfo("6000,run ning,1h00m", 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, ErrUnknownActivity)
+	assert.EqualError(suite.T(), err, `неизвестный тип тренировки: run ning (возможно, имелось в виду "running")`)
+
+	_, err = TrainingInfo("6000,нордическая ходьба,1h00m", 75.0, 1.75)
+	assert.EqualError(suite.T(), err, "неизвестный тип тренировки: нордическая ходьба")
+}
+
+func (suite *SpentCaloriesTestSuite) TestActivityAliases() {
+	defer func() {
+		delete(ActivityAliases, "jogging")
+		delete(ActivityAliases, "прогулка")
+		delete(ActivityAliases, "плавание")
+	}()
+
+	ActivityAliases["jogging"] = activityRunning
+	ActivityAliases["прогулка"] = activityWalking
+
+	got, err := TrainingInfo("6000,Jogging,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Сожгли калорий: 354.38")
+
+	got, err = TrainingInfo("6000,Прогулка,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Сожгли калорий: 177.19")
+
+	// Синоним, указывающий не на встроенную активность, не учитывается
+	ActivityAliases["плавание"] = "плавание"
+
+	_, ok := canonicalActivity("Плавание")
+	assert.False(suite.T(), ok)
+}
+
+func (suite *SpentCaloriesTestSuite) TestIsStepActivity() {
+	assert.True(suite.T(), IsStepActivity("Бег"))
+	assert.True(suite.T(), IsStepActivity("walk"))
+	assert.True(suite.T(), IsStepActivity("Гребля"))
+	assert.False(suite.T(), IsStepActivity("Велосипед"))
+	assert.False(suite.T(), IsStepActivity(" swimming "))
+}
@@ -0,0 +1,95 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+)
+
+// BMRFormula — формула основного обмена для поправки калорий на возраст и пол.
+type BMRFormula int
+
+const (
+	// BMRMifflinStJeor — формула Миффлина — Сан Жеора (по умолчанию).
+	BMRMifflinStJeor BMRFormula = iota
+	// BMRHarrisBenedict — пересмотренная формула Харриса — Бенедикта.
+	BMRHarrisBenedict
+)
+
+// Коэффициенты пересмотренной формулы Харриса — Бенедикта (Roza, Shizgal, 1984)
+// для основного обмена в ккал/сутки: base + weight*вес + height*рост в см - age*возраст.
+type harrisBenedictCoefficients struct {
+	base, weight, height, age float64
+}
+
+var (
+	harrisBenedictMale   = harrisBenedictCoefficients{base: 88.362, weight: 13.397, height: 4.799, age: 5.677}
+	harrisBenedictFemale = harrisBenedictCoefficients{base: 447.593, weight: 9.247, height: 3.098, age: 4.330}
+)
+
+func (k harrisBenedictCoefficients) bmr(weight, height float64, age int) float64 {
+	return k.base + k.weight*weight + k.height*height*cmInM - k.age*float64(age)
+}
+
+// harrisBenedictBMR возвращает основной обмен в ккал/сутки по формуле Харриса — Бенедикта.
+// Если пол не указан, берётся среднее значение мужской и женской формулы.
+func harrisBenedictBMR(weight, height float64, age int, sex Sex) float64 {
+	switch sex {
+	case SexMale:
+		return harrisBenedictMale.bmr(weight, height, age)
+	case SexFemale:
+		return harrisBenedictFemale.bmr(weight, height, age)
+	default:
+		return (harrisBenedictMale.bmr(weight, height, age) + harrisBenedictFemale.bmr(weight, height, age)) / 2
+	}
+}
+
+func basalMetabolicRate(formula BMRFormula, weight, height float64, age int, sex Sex) float64 {
+	if formula == BMRHarrisBenedict {
+		return harrisBenedictBMR(weight, height, age, sex)
+	}
+	return mifflinBMR(weight, height, age, sex)
+}
+
+// RunningSpentCaloriesV2 работает как RunningSpentCalories, но учитывает возраст и пол:
+// результат умножается на отношение основного обмена пользователя к основному обмену
+// человека того же веса и роста в возрасте 30 лет без учета пола. Формула основного
+// обмена задаётся SetBMRFormula. Возраст 0 означает, что он не указан.
+func RunningSpentCaloriesV2(steps int, weight, height float64, age int, sex Sex, duration time.Duration) (float64, error) {
+	calories, err := RunningSpentCalories(steps, weight, height, duration)
+	if err != nil {
+		return 0, err
+	}
+
+	return adjustForAgeAndSex(calories, weight, height, age, sex)
+}
+
+// WalkingSpentCaloriesV2 работает как WalkingSpentCalories, но учитывает возраст и пол
+// так же, как RunningSpentCaloriesV2.
+func WalkingSpentCaloriesV2(steps int, weight, height float64, age int, sex Sex, duration time.Duration) (float64, error) {
+	calories, err := WalkingSpentCalories(steps, weight, height, duration)
+	if err != nil {
+		return 0, err
+	}
+
+	return adjustForAgeAndSex(calories, weight, height, age, sex)
+}
+
+func adjustForAgeAndSex(calories, weight, height float64, age int, sex Sex) (float64, error) {
+	// Проверка возраста
+	if age < 0 || age > 120 {
+		return 0, fmt.Errorf("возраст должен быть в диапазоне от 0 до 120 лет")
+	}
+	if age == 0 {
+		age = bmrDefaultAge
+	}
+
+	// Сравниваем основной обмен пользователя с обменом усреднённого человека того же сложения
+	formula := currentSettings().bmrFormula
+	personal := basalMetabolicRate(formula, weight, height, age, sex)
+	reference := basalMetabolicRate(formula, weight, height, bmrDefaultAge, SexUnknown)
+	if personal <= 0 || reference <= 0 {
+		return 0, fmt.Errorf("не удалось рассчитать основной обмен")
+	}
+
+	return calories * personal / reference, nil
+}
@@ -0,0 +1,88 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestSpentCaloriesV2() {
+	// Основной обмен по формуле Миффлина — Сан Жеора для 75 кг и 175 см
+	const neutral30 = 10*75 + 6.25*175 - 5*30 - 78.0
+
+	tests := []struct {
+		name    string
+		age     int
+		sex     Sex
+		want    float64
+		wantErr bool
+	}{
+		{
+			name:    "возраст и пол не указаны",
+			age:     0,
+			sex:     SexUnknown,
+			want:    354.375,
+			wantErr: false,
+		},
+		{
+			name:    "мужчина 30 лет",
+			age:     30,
+			sex:     SexMale,
+			want:    354.375 * (neutral30 + 83) / neutral30,
+			wantErr: false,
+		},
+		{
+			name:    "женщина 50 лет",
+			age:     50,
+			sex:     SexFemale,
+			want:    354.375 * (neutral30 - 100 - 83) / neutral30,
+			wantErr: false,
+		},
+		{
+			name:    "отрицательный возраст",
+			age:     -1,
+			sex:     SexMale,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := RunningSpentCaloriesV2(6000, 75.0, 1.75, tt.age, tt.sex, time.Hour)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+
+			// Ходьба корректируется тем же множителем
+			walking, err := WalkingSpentCaloriesV2(6000, 75.0, 1.75, tt.age, tt.sex, time.Hour)
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want/2, walking, 1e-9)
+		})
+	}
+
+	_, err := RunningSpentCaloriesV2(0, 75.0, 1.75, 30, SexMale, time.Hour)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *SpentCaloriesTestSuite) TestSetBMRFormula() {
+	defer func() {
+		_ = SetBMRFormula(BMRMifflinStJeor)
+	}()
+
+	assert.NoError(suite.T(), SetBMRFormula(BMRHarrisBenedict))
+
+	male := 88.362 + 13.397*75 + 4.799*175 - 5.677*30
+	female := 447.593 + 9.247*75 + 3.098*175 - 4.330*30
+
+	got, err := RunningSpentCaloriesV2(6000, 75.0, 1.75, 30, SexMale, time.Hour)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 354.375*male/((male+female)/2), got, 1e-9)
+
+	assert.Error(suite.T(), SetBMRFormula(BMRFormula(7)))
+	assert.Equal(suite.T(), BMRHarrisBenedict, currentSettings().bmrFormula)
+}
@@ -0,0 +1,51 @@
+package spentcalories
+
+import "fmt"
+
+// ActivityBalanceScore оценивает разнообразие тренировок за неделю по индексу Джини–Симпсона:
+// 1 минус сумма квадратов долей калорий каждого вида активности.
+// Результат равен 0, если все калории получены от одного вида активности,
+// и растёт к 1 по мере равномерного распределения между видами.
+func ActivityBalanceScore(days [][]string, weight, height float64) (float64, []error) {
+	var (
+		total float64
+		errs  []error
+	)
+	byActivity := make(map[string]float64)
+
+	for i, day := range days {
+		for j, data := range day {
+			t, err := NewTraining(data, weight, height)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("день %d, запись %d: %w", i+1, j+1, err))
+				continue
+			}
+
+			byActivity[activityKey(t.Activity)] += t.Calories
+			total += t.Calories
+		}
+	}
+
+	if total <= 0 {
+		return 0, errs
+	}
+
+	// Вычисляем индекс Джини–Симпсона по долям калорий
+	score := 1.0
+	for _, calories := range byActivity {
+		share := calories / total
+		score -= share * share
+	}
+
+	return score, errs
+}
+
+// activityKey возвращает ключ для группировки тренировок по виду активности,
+// объединяя синонимы встроенных активностей.
+func activityKey(activity string) string {
+	if kind, ok := canonicalActivity(activity); ok {
+		return kind
+	}
+
+	return normalizeActivity(activity)
+}
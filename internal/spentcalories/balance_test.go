@@ -0,0 +1,42 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestActivityBalanceScore() {
+	singleActivity := [][]string{
+		{"6000,Бег,1h00m"},
+		{"3000,run,30m"},
+		{"4000,Running,40m"},
+	}
+
+	got, errs := ActivityBalanceScore(singleActivity, 75.0, 1.75)
+	assert.Empty(suite.T(), errs)
+	assert.InDelta(suite.T(), 0.0, got, 1e-9)
+
+	// Бег и ходьба с одинаковыми калориями дают индекс 0.5
+	mixed := [][]string{
+		{"6000,Бег,1h00m"},
+		{"6000,Ходьба,1h00m", "6000,walk,1h00m"},
+	}
+
+	got, errs = ActivityBalanceScore(mixed, 75.0, 1.75)
+	assert.Empty(suite.T(), errs)
+	assert.InDelta(suite.T(), 0.5, got, 1e-9)
+
+	withErrors := [][]string{
+		{"6000,Бег,1h00m", "abc,Бег,1h00m"},
+		{"6000,Плавание,1h00m"},
+	}
+
+	got, errs = ActivityBalanceScore(withErrors, 75.0, 1.75)
+	assert.Len(suite.T(), errs, 2)
+	assert.ErrorContains(suite.T(), errs[0], "день 1, запись 2")
+	assert.ErrorContains(suite.T(), errs[1], "день 2, запись 1")
+	assert.InDelta(suite.T(), 0.0, got, 1e-9)
+
+	got, errs = ActivityBalanceScore(nil, 75.0, 1.75)
+	assert.Empty(suite.T(), errs)
+	assert.Equal(suite.T(), 0.0, got)
+}
@@ -0,0 +1,32 @@
+package spentcalories
+
+import "time"
+
+// CaloriesPerMinute распределяет калории тренировки по полным минутам.
+// Скорость считается постоянной, поэтому расход за каждую минуту одинаков,
+// а калории неполной последней минуты добавляются к последнему элементу.
+// Для тренировки короче минуты возвращается пустой срез.
+func CaloriesPerMinute(steps int, weight, height float64, duration time.Duration, activity string) ([]float64, error) {
+	total, err := spentCalories(activity, steps, weight, height, duration)
+	if err != nil {
+		return nil, err
+	}
+
+	// В тренировке короче минуты нет ни одной полной минуты
+	minutes := int(duration / time.Minute)
+	if minutes == 0 {
+		return []float64{}, nil
+	}
+
+	perMinute := total / duration.Minutes()
+
+	result := make([]float64, minutes)
+	for i := range result {
+		result[i] = perMinute
+	}
+
+	// Добавляем остаток неполной минуты
+	result[minutes-1] += total - perMinute*float64(minutes)
+
+	return result, nil
+}
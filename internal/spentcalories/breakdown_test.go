@@ -0,0 +1,89 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestCaloriesPerMinute() {
+	tests := []struct {
+		name      string
+		steps     int
+		duration  time.Duration
+		activity  string
+		wantLen   int
+		wantFirst float64
+		wantTotal float64
+		wantErr   bool
+	}{
+		{
+			name:      "бег - один час",
+			steps:     6000,
+			duration:  1 * time.Hour,
+			activity:  "Бег",
+			wantLen:   60,
+			wantFirst: 5.90625,
+			wantTotal: 354.375,
+			wantErr:   false,
+		},
+		{
+			name:      "ходьба - неполная минута в конце",
+			steps:     3000,
+			duration:  30*time.Minute + 30*time.Second,
+			activity:  "Ходьба",
+			wantLen:   30,
+			wantFirst: 2.90471,
+			wantTotal: 88.59375,
+			wantErr:   false,
+		},
+		{
+			name:      "тренировка короче минуты",
+			steps:     100,
+			duration:  30 * time.Second,
+			activity:  "Бег",
+			wantLen:   0,
+			wantTotal: 0,
+			wantErr:   false,
+		},
+		{
+			name:     "неизвестная активность",
+			steps:    6000,
+			duration: 1 * time.Hour,
+			activity: "Плавание",
+			wantErr:  true,
+		},
+		{
+			name:     "нулевая продолжительность",
+			steps:    6000,
+			duration: 0,
+			activity: "Бег",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := CaloriesPerMinute(tt.steps, 75.0, 1.75, tt.duration, tt.activity)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Nil(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.NotNil(suite.T(), got)
+			assert.Len(suite.T(), got, tt.wantLen)
+			if tt.wantLen > 0 {
+				assert.InDelta(suite.T(), tt.wantFirst, got[0], 0.001)
+			}
+
+			var sum float64
+			for _, c := range got {
+				sum += c
+			}
+			assert.InDelta(suite.T(), tt.wantTotal, sum, 1e-9)
+		})
+	}
+}
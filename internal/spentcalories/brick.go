@@ -0,0 +1,24 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+)
+
+// BrickCalories рассчитывает калории для связки «велосипед — бег»: езда на велосипеде
+// с bikeRevs оборотами педалей за bikeDur и затем бег с runSteps шагами за runDur.
+func BrickCalories(bikeRevs int, bikeDur time.Duration, runSteps int, runDur time.Duration, weight, height float64) (float64, error) {
+	// Рассчитываем калории велосипедного этапа
+	bike, err := CyclingSpentCalories(bikeRevs, weight, bikeDur)
+	if err != nil {
+		return 0, fmt.Errorf("велосипедный этап: %w", err)
+	}
+
+	// Рассчитываем калории бегового этапа
+	run, err := RunningSpentCalories(runSteps, weight, height, runDur)
+	if err != nil {
+		return 0, fmt.Errorf("беговой этап: %w", err)
+	}
+
+	return bike + run, nil
+}
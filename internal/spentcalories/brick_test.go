@@ -0,0 +1,66 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestBrickCalories() {
+	tests := []struct {
+		name     string
+		bikeRevs int
+		bikeDur  time.Duration
+		runSteps int
+		runDur   time.Duration
+		want     float64
+		wantErr  bool
+	}{
+		{
+			name:     "короткая связка: 20 минут велосипеда и 15 минут бега",
+			bikeRevs: 1500,
+			bikeDur:  20 * time.Minute,
+			runSteps: 3000,
+			runDur:   15 * time.Minute,
+			want:     300 + 177.1875,
+			wantErr:  false,
+		},
+		{
+			name:     "нет оборотов педалей",
+			bikeRevs: 0,
+			bikeDur:  20 * time.Minute,
+			runSteps: 3000,
+			runDur:   15 * time.Minute,
+			wantErr:  true,
+		},
+		{
+			name:     "нулевая длительность бега",
+			bikeRevs: 1500,
+			bikeDur:  20 * time.Minute,
+			runSteps: 3000,
+			runDur:   0,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := BrickCalories(tt.bikeRevs, tt.bikeDur, tt.runSteps, tt.runDur, 75.0, 1.75)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestCyclingMET() {
+	assert.Equal(suite.T(), 4.0, cyclingMET(12))
+	assert.Equal(suite.T(), 8.0, cyclingMET(19))
+	assert.Equal(suite.T(), 12.0, cyclingMET(27))
+	assert.Equal(suite.T(), cyclingMETMax, cyclingMET(36))
+}
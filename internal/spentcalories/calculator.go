@@ -0,0 +1,69 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+)
+
+// CalorieCalculator — стратегия расчета калорий для вида активности.
+type CalorieCalculator interface {
+	SpentCalories(steps int, weight, height float64, duration time.Duration) (float64, error)
+}
+
+// RunningCalculator рассчитывает калории для бега.
+type RunningCalculator struct{}
+
+// SpentCalories рассчитывает калории так же, как RunningSpentCalories.
+func (RunningCalculator) SpentCalories(steps int, weight, height float64, duration time.Duration) (float64, error) {
+	return RunningSpentCalories(steps, weight, height, duration)
+}
+
+// WalkingCalculator рассчитывает калории для ходьбы.
+type WalkingCalculator struct{}
+
+// SpentCalories рассчитывает калории так же, как WalkingSpentCalories.
+func (WalkingCalculator) SpentCalories(steps int, weight, height float64, duration time.Duration) (float64, error) {
+	return WalkingSpentCalories(steps, weight, height, duration)
+}
+
+// CyclingCalculator рассчитывает калории для езды на велосипеде.
+// Вместо шагов передаётся количество оборотов педалей, рост не используется.
+type CyclingCalculator struct{}
+
+// SpentCalories рассчитывает калории так же, как CyclingSpentCalories.
+func (CyclingCalculator) SpentCalories(revs int, weight, _ float64, duration time.Duration) (float64, error) {
+	return CyclingSpentCalories(revs, weight, duration)
+}
+
+// SpentCalories позволяет использовать CalorieFunc как CalorieCalculator.
+func (f CalorieFunc) SpentCalories(steps int, weight, height float64, duration time.Duration) (float64, error) {
+	return f(steps, weight, height, duration)
+}
+
+// CalculatorFor возвращает стратегию расчета калорий для вида активности из реестра:
+// встроенную для бега, ходьбы и велосипеда или зарегистрированную через RegisterActivity.
+func CalculatorFor(activity string) (CalorieCalculator, error) {
+	calc, ok := lookupActivity(activity)
+	if !ok {
+		return nil, unknownActivityError(activity)
+	}
+
+	return calc, nil
+}
+
+// validateTrainingInputs проверяет общие входные параметры расчета калорий по шагам.
+func validateTrainingInputs(steps int, weight, height float64, duration time.Duration) error {
+	if err := validateSteps(steps); err != nil {
+		return err
+	}
+	if weight <= 0 {
+		return fmt.Errorf("вес должен быть больше 0")
+	}
+	if err := validateHeight(height); err != nil {
+		return err
+	}
+	if duration <= 0 {
+		return ErrNonPositiveDuration
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestCalorieCalculators() {
+	tests := []struct {
+		name string
+		calc CalorieCalculator
+		want float64
+	}{
+		{
+			name: "бег",
+			calc: RunningCalculator{},
+			want: 354.375,
+		},
+		{
+			name: "ходьба",
+			calc: WalkingCalculator{},
+			want: 177.1875,
+		},
+		{
+			name: "функция как стратегия",
+			calc: CalorieFunc(func(steps int, weight, height float64, duration time.Duration) (float64, error) {
+				return weight * duration.Hours(), nil
+			}),
+			want: 75,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := tt.calc.SpentCalories(6000, 75.0, 1.75, time.Hour)
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestCalculatorFor() {
+	calc, err := CalculatorFor(" Running ")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), RunningCalculator{}, calc)
+
+	calc, err = CalculatorFor("Ходьба")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), WalkingCalculator{}, calc)
+
+	calc, err = CalculatorFor("Плавание")
+	assert.ErrorContains(suite.T(), err, "неизвестный тип тренировки")
+	assert.Nil(suite.T(), calc)
+}
+
+func (suite *SpentCaloriesTestSuite) TestValidateTrainingInputs() {
+	assert.NoError(suite.T(), validateTrainingInputs(6000, 75.0, 1.75, time.Hour))
+	assert.ErrorContains(suite.T(), validateTrainingInputs(0, 75.0, 1.75, time.Hour), "шагов")
+	assert.ErrorContains(suite.T(), validateTrainingInputs(6000, 0, 1.75, time.Hour), "вес")
+	assert.ErrorContains(suite.T(), validateTrainingInputs(6000, 75.0, 0, time.Hour), "рост")
+	assert.ErrorContains(suite.T(), validateTrainingInputs(6000, 75.0, 1.75, 0), "длительность")
+}
@@ -0,0 +1,28 @@
+package spentcalories
+
+import (
+	"fmt"
+	"math"
+)
+
+// CorrectedSteps применяет к показаниям шагомера калибровочный коэффициент:
+// при factor > 1 шагомер недосчитывает шаги, при factor < 1 — пересчитывает.
+// Результат округляется до целого и используется далее в расчетах дистанции и калорий.
+func CorrectedSteps(rawSteps int, correctionFactor float64) (int, error) {
+	// Проверка входных параметров
+	if rawSteps < 0 {
+		return 0, fmt.Errorf("количество шагов не может быть отрицательным")
+	}
+	if correctionFactor <= 0 || math.IsInf(correctionFactor, 0) || math.IsNaN(correctionFactor) {
+		return 0, fmt.Errorf("коэффициент коррекции должен быть положительным числом")
+	}
+
+	corrected := math.Round(float64(rawSteps) * correctionFactor)
+
+	// Защищаемся от переполнения при переводе обратно в int
+	if corrected >= math.MaxInt {
+		return 0, fmt.Errorf("скорректированное количество шагов слишком велико")
+	}
+
+	return int(corrected), nil
+}
@@ -0,0 +1,68 @@
+package spentcalories
+
+import (
+	"math"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestCorrectedSteps() {
+	tests := []struct {
+		name    string
+		raw     int
+		factor  float64
+		want    int
+		wantErr bool
+	}{
+		{
+			name:   "шагомер недосчитывает на 5%",
+			raw:    6000,
+			factor: 1.05,
+			want:   6300,
+		},
+		{
+			name:   "округление до целого",
+			raw:    1001,
+			factor: 0.95,
+			want:   951,
+		},
+		{
+			name:   "без коррекции",
+			raw:    6000,
+			factor: 1,
+			want:   6000,
+		},
+		{
+			name:    "нулевой коэффициент",
+			raw:     6000,
+			factor:  0,
+			wantErr: true,
+		},
+		{
+			name:    "бесконечный коэффициент",
+			raw:     6000,
+			factor:  math.Inf(1),
+			wantErr: true,
+		},
+		{
+			name:    "переполнение",
+			raw:     math.MaxInt,
+			factor:  2,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := CorrectedSteps(tt.raw, tt.factor)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package spentcalories
+
+import "fmt"
+
+// carCO2GramsPerKm — средний выброс CO2 легкового автомобиля в граммах на километр.
+// Значение соответствует среднему показателю для бензинового автомобиля в городском цикле.
+const carCO2GramsPerKm = 120
+
+// CarbonSaved оценивает количество CO2 в граммах, которое не попало в атмосферу благодаря тому,
+// что дистанция distanceKm пройдена пешком или пробежкой, а не на автомобиле.
+func CarbonSaved(distanceKm float64) float64 {
+	if distanceKm <= 0 {
+		return 0
+	}
+
+	return distanceKm * carCO2GramsPerKm
+}
+
+// CommuteReportResult — сравнение активной дороги на работу с поездкой на автомобиле.
+type CommuteReportResult struct {
+	Calories    float64 // калории, сожжённые в активных поездках.
+	Distance    float64 // дистанция активных поездок в километрах.
+	CarbonSaved float64 // CO2 в граммах, не выброшенный автомобилем на том же расстоянии.
+}
+
+// CommuteReport рассчитывает калории для активных поездок в формате "шаги,активность,длительность"
+// и оценивает выброс CO2, которого удалось избежать, не проехав carDistanceKm на автомобиле.
+// За рулём дополнительные калории почти не расходуются, поэтому все калории активных поездок
+// считаются выигрышем по сравнению с автомобилем.
+func CommuteReport(activeLines []string, weight, height float64, carDistanceKm float64) (CommuteReportResult, error) {
+	if carDistanceKm < 0 {
+		return CommuteReportResult{}, fmt.Errorf("дистанция на автомобиле не может быть отрицательной")
+	}
+
+	var result CommuteReportResult
+	for i, line := range activeLines {
+		t, err := NewTraining(line, weight, height)
+		if err != nil {
+			return CommuteReportResult{}, withLine(err, i+1)
+		}
+
+		result.Calories += t.Calories
+		result.Distance += t.Distance
+	}
+
+	result.CarbonSaved = CarbonSaved(carDistanceKm)
+
+	return result, nil
+}
@@ -0,0 +1,55 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestCarbonSaved() {
+	tests := []struct {
+		name       string
+		distanceKm float64
+		want       float64
+	}{
+		{
+			name:       "5 км",
+			distanceKm: 5,
+			want:       600,
+		},
+		{
+			name:       "нулевая дистанция",
+			distanceKm: 0,
+			want:       0,
+		},
+		{
+			name:       "отрицательная дистанция",
+			distanceKm: -5,
+			want:       0,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got := CarbonSaved(tt.distanceKm)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestCommuteReport() {
+	lines := []string{
+		"3000,Ходьба,30m",
+		"3000,Ходьба,30m",
+	}
+
+	got, err := CommuteReport(lines, 75.0, 1.75, 4.0)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 177.1875, got.Calories, 1e-9)
+	assert.InDelta(suite.T(), 4.725, got.Distance, 1e-9)
+	assert.InDelta(suite.T(), 480, got.CarbonSaved, 1e-9)
+
+	_, err = CommuteReport([]string{"3000,Ходьба,30m", "abc"}, 75.0, 1.75, 4.0)
+	assert.ErrorContains(suite.T(), err, "строка 2")
+
+	_, err = CommuteReport(lines, 75.0, 1.75, -1)
+	assert.Error(suite.T(), err)
+}
@@ -0,0 +1,81 @@
+package spentcalories
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TrainingDelta — разница показателей двух тренировок. Положительные значения
+// означают, что первая тренировка больше второй; отрицательная разница темпа
+// означает, что первая тренировка быстрее.
+type TrainingDelta struct {
+	Distance        float64       // разница дистанции в километрах.
+	Calories        float64       // разница калорий.
+	Duration        time.Duration // разница продолжительности.
+	Speed           float64       // разница средней скорости в км/ч.
+	Pace            float64       // разница темпа в минутах на километр.
+	ActivityChanged bool          // тренировки относятся к разным видам активности.
+}
+
+// CompareTraining возвращает разницу показателей тренировки a относительно тренировки b.
+// Для тренировок разных видов активности разница тоже рассчитывается,
+// но устанавливается флаг ActivityChanged.
+func CompareTraining(a, b TrainingResult) TrainingDelta {
+	return TrainingDelta{
+		Distance:        a.Distance - b.Distance,
+		Calories:        a.Calories - b.Calories,
+		Duration:        a.Duration - b.Duration,
+		Speed:           a.Speed - b.Speed,
+		Pace:            AveragePace([]TrainingResult{a}) - AveragePace([]TrainingResult{b}),
+		ActivityChanged: !sameActivity(a.Activity, b.Activity),
+	}
+}
+
+func sameActivity(a, b string) bool {
+	// Синонимы встроенных активностей считаются одной активностью
+	kindA, okA := canonicalActivity(a)
+	kindB, okB := canonicalActivity(b)
+	if okA && okB {
+		return kindA == kindB
+	}
+
+	return normalizeActivity(a) == normalizeActivity(b)
+}
+
+// String форматирует разницу со знаками, например "+0.80 км, +120 ккал, +5m0s, +1.00 км/ч, темп быстрее на 30s".
+func (d TrainingDelta) String() string {
+	// Продолжительность выводим со знаком
+	duration := d.Duration.String()
+	if d.Duration >= 0 {
+		duration = "+" + duration
+	}
+
+	// Разницу темпа переводим в секунды на километр
+	paceDelta := time.Duration(d.Pace * float64(time.Minute)).Round(time.Second)
+
+	var pace string
+	switch {
+	case paceDelta < 0:
+		pace = fmt.Sprintf("темп быстрее на %s", -paceDelta)
+	case paceDelta > 0:
+		pace = fmt.Sprintf("темп медленнее на %s", paceDelta)
+	default:
+		pace = "темп без изменений"
+	}
+
+	parts := []string{
+		fmt.Sprintf("%+.2f км", d.Distance),
+		fmt.Sprintf("%+.0f ккал", d.Calories),
+		duration,
+		fmt.Sprintf("%+.2f км/ч", d.Speed),
+		pace,
+	}
+
+	result := strings.Join(parts, ", ")
+	if d.ActivityChanged {
+		result += " (другой вид активности)"
+	}
+
+	return result
+}
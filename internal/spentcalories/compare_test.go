@@ -0,0 +1,27 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestCompareTraining() {
+	today := TrainingResult{Activity: "Бег", Distance: 5.5, Duration: 30 * time.Minute, Speed: 11, Calories: 400}
+	lastWeek := TrainingResult{Activity: "running", Distance: 5.0, Duration: 30 * time.Minute, Speed: 10, Calories: 280}
+
+	got := CompareTraining(today, lastWeek)
+	assert.InDelta(suite.T(), 0.5, got.Distance, 1e-9)
+	assert.InDelta(suite.T(), 120, got.Calories, 1e-9)
+	assert.Equal(suite.T(), time.Duration(0), got.Duration)
+	assert.InDelta(suite.T(), 1, got.Speed, 1e-9)
+	assert.InDelta(suite.T(), -0.5455, got.Pace, 1e-4)
+	assert.False(suite.T(), got.ActivityChanged)
+	assert.Equal(suite.T(), "+0.50 км, +120 ккал, +0s, +1.00 км/ч, темп быстрее на 33s", got.String())
+
+	walk := TrainingResult{Activity: "Ходьба", Distance: 4.0, Duration: 50 * time.Minute, Speed: 4.8, Calories: 150}
+
+	got = CompareTraining(walk, lastWeek)
+	assert.True(suite.T(), got.ActivityChanged)
+	assert.Equal(suite.T(), "-1.00 км, -130 ккал, +20m0s, -5.20 км/ч, темп медленнее на 6m30s (другой вид активности)", got.String())
+}
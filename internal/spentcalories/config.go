@@ -0,0 +1,122 @@
+package spentcalories
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// settings — настраиваемые параметры расчетов.
+type settings struct {
+	walkingCoefficient float64       // коэффициент для расчета калорий при ходьбе.
+	bareDurationUnit   time.Duration // единица для длительности без единицы измерения; 0 — не допускается.
+	minStepLength      float64       // минимальная правдоподобная длина шага в метрах; 0 — без ограничения.
+	calorieMode        CalorieMode   // способ расчета калорий для бега и ходьбы.
+	bmrFormula         BMRFormula    // формула основного обмена для поправки на возраст и пол.
+}
+
+// Текущие параметры расчетов. Set* функции можно вызывать одновременно с расчетами
+// из нескольких горутин.
+var (
+	configMu sync.RWMutex
+	config   = settings{
+		walkingCoefficient: walkingCaloriesCoefficient,
+		calorieMode:        CalorieModeSpeed,
+		bmrFormula:         BMRMifflinStJeor,
+	}
+)
+
+// currentSettings возвращает копию текущих параметров расчетов.
+func currentSettings() settings {
+	configMu.RLock()
+	defer configMu.RUnlock()
+
+	return config
+}
+
+// updateSettings изменяет параметры расчетов под блокировкой.
+func updateSettings(fn func(*settings)) {
+	configMu.Lock()
+	defer configMu.Unlock()
+
+	fn(&config)
+}
+
+// CalorieMode — способ расчета калорий для бега и ходьбы.
+type CalorieMode int
+
+const (
+	// CalorieModeSpeed — расчет по формуле вес * скорость * минуты / 60 (по умолчанию).
+	CalorieModeSpeed CalorieMode = iota
+	// CalorieModeMET — расчет по таблице MET через CaloriesMET.
+	CalorieModeMET
+)
+
+// SetWalkingCoefficient задаёт коэффициент для расчета калорий при ходьбе.
+// По умолчанию используется значение 0.5.
+func SetWalkingCoefficient(c float64) error {
+	if c <= 0 {
+		return fmt.Errorf("коэффициент для ходьбы должен быть больше 0")
+	}
+
+	updateSettings(func(s *settings) { s.walkingCoefficient = c })
+	return nil
+}
+
+// SetBareDurationUnit разрешает указывать длительность числом без единицы измерения
+// и задаёт, в каких единицах это число трактуется. Например, при unit = time.Minute
+// строка "90" означает полтора часа, а при unit = time.Hour строка "1.5" — тоже полтора часа.
+// Длительность больше 24 часов, например "90" при unit = time.Hour, отклоняется.
+//
+// Нулевое значение (по умолчанию) запрещает такую запись: "90" — ошибка, так как без
+// единицы нельзя понять, минуты это или часы. Для записей шагомера, где длительность
+// указана в минутах, используйте SetBareDurationUnit(time.Minute).
+func SetBareDurationUnit(unit time.Duration) error {
+	if unit < 0 {
+		return fmt.Errorf("единица длительности не может быть отрицательной")
+	}
+
+	updateSettings(func(s *settings) { s.bareDurationUnit = unit })
+	return nil
+}
+
+// SetMinStepLength задаёт минимальную правдоподобную длину шага в метрах.
+// Если длина шага, рассчитанная по росту, меньше этого значения, используется
+// средняя длина шага 0.65 м. Нулевое значение (по умолчанию) отключает проверку.
+func SetMinStepLength(m float64) error {
+	if m < 0 {
+		return fmt.Errorf("минимальная длина шага не может быть отрицательной")
+	}
+	if m > lenStep {
+		return fmt.Errorf("минимальная длина шага не может превышать среднюю длину шага %.2f м", lenStep)
+	}
+
+	updateSettings(func(s *settings) { s.minStepLength = m })
+	return nil
+}
+
+// SetCalorieMode задаёт способ расчета калорий в RunningSpentCalories и WalkingSpentCalories.
+// По умолчанию используется CalorieModeSpeed.
+func SetCalorieMode(mode CalorieMode) error {
+	switch mode {
+	case CalorieModeSpeed, CalorieModeMET:
+	default:
+		return fmt.Errorf("неизвестный способ расчета калорий: %d", mode)
+	}
+
+	updateSettings(func(s *settings) { s.calorieMode = mode })
+	return nil
+}
+
+// SetBMRFormula задаёт формулу основного обмена, по которой RunningSpentCaloriesV2
+// и WalkingSpentCaloriesV2 учитывают возраст и пол. По умолчанию используется BMRMifflinStJeor.
+func SetBMRFormula(formula BMRFormula) error {
+	switch formula {
+	case BMRMifflinStJeor, BMRHarrisBenedict:
+	default:
+		return fmt.Errorf("неизвестная формула основного обмена: %d", formula)
+	}
+
+	updateSettings(func(s *settings) { s.bmrFormula = formula })
+	return nil
+}
@@ -0,0 +1,53 @@
+package spentcalories
+
+import (
+	"sync"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestSetWalkingCoefficient() {
+	defer func() {
+		_ = SetWalkingCoefficient(walkingCaloriesCoefficient)
+	}()
+
+	base, err := WalkingSpentCalories(6000, 75.0, 1.75, time.Hour)
+	assert.NoError(suite.T(), err)
+
+	assert.NoError(suite.T(), SetWalkingCoefficient(0.35))
+
+	got, err := WalkingSpentCalories(6000, 75.0, 1.75, time.Hour)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), base*0.35/walkingCaloriesCoefficient, got, 1e-9)
+
+	assert.Error(suite.T(), SetWalkingCoefficient(0))
+	assert.Error(suite.T(), SetWalkingCoefficient(-0.5))
+	assert.Equal(suite.T(), 0.35, currentSettings().walkingCoefficient)
+}
+
+func (suite *SpentCaloriesTestSuite) TestSettingsConcurrent() {
+	defer func() {
+		_ = SetWalkingCoefficient(walkingCaloriesCoefficient)
+		_ = SetCalorieMode(CalorieModeSpeed)
+	}()
+
+	var wg sync.WaitGroup
+	errs := make([]error, 20)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if i%2 == 0 {
+				errs[i] = SetWalkingCoefficient(0.4 + float64(i)/100)
+				return
+			}
+			_, errs[i] = WalkingSpentCalories(6000, 75.0, 1.75, time.Hour)
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		assert.NoError(suite.T(), err)
+	}
+}
@@ -0,0 +1,94 @@
+package spentcalories
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Названия обязательных столбцов CSV-файла тренировок.
+const (
+	csvColumnSteps    = "steps"
+	csvColumnActivity = "activity"
+	csvColumnDuration = "duration"
+)
+
+// TrainingRecord — запись тренировки, разобранная из CSV-файла или Scanner.
+type TrainingRecord struct {
+	Line     int           // номер строки в файле, начиная с 1.
+	Steps    int           // количество шагов.
+	Activity string        // вид активности.
+	Duration time.Duration // продолжительность тренировки.
+}
+
+// ParseTrainingsCSV разбирает CSV-файл тренировок, например экспорт шагомера.
+// Первая строка — заголовок со столбцами steps, activity и duration в любом порядке,
+// остальные столбцы игнорируются. Записи проверяются по тем же правилам, что и строковый
+// формат; ошибка содержит номер строки файла.
+func ParseTrainingsCSV(r io.Reader) ([]TrainingRecord, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	// Находим положение обязательных столбцов по заголовку
+	columns, err := ReadCSVHeader(reader, csvColumnSteps, csvColumnActivity, csvColumnDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []TrainingRecord
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("неверный формат CSV: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		steps, activity, duration, err := parseTrainingFields(row[columns[0]], row[columns[1]], row[columns[2]])
+		if err != nil {
+			return nil, withLine(err, line)
+		}
+
+		records = append(records, TrainingRecord{
+			Line:     line,
+			Steps:    steps,
+			Activity: activity,
+			Duration: duration,
+		})
+	}
+
+	return records, nil
+}
+
+// ReadCSVHeader читает заголовок CSV-файла и возвращает номера столбцов с названиями
+// names в том же порядке. Названия сравниваются без учета регистра и пробелов по краям.
+func ReadCSVHeader(reader *csv.Reader, names ...string) ([]int, error) {
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("в CSV-файле отсутствует заголовок")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("неверный формат CSV: %w", err)
+	}
+
+	positions := make(map[string]int, len(header))
+	for i, name := range header {
+		positions[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	columns := make([]int, 0, len(names))
+	for _, name := range names {
+		i, ok := positions[name]
+		if !ok {
+			return nil, fmt.Errorf("в заголовке CSV-файла отсутствует столбец %q", name)
+		}
+		columns = append(columns, i)
+	}
+
+	return columns, nil
+}
@@ -0,0 +1,91 @@
+package spentcalories
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestParseTrainingsCSV() {
+	tests := []struct {
+		name      string
+		input     string
+		want      []TrainingRecord
+		wantLine  int
+		wantField string
+		wantErr   string
+	}{
+		{
+			name:  "корректный файл",
+			input: "steps,activity,duration\n6000,Бег,1h00m\n3456,Ходьба,3h00m\n",
+			want: []TrainingRecord{
+				{Line: 2, Steps: 6000, Activity: "Бег", Duration: time.Hour},
+				{Line: 3, Steps: 3456, Activity: "Ходьба", Duration: 3 * time.Hour},
+			},
+		},
+		{
+			name:  "другой порядок и лишние столбцы",
+			input: "Date, Duration, Steps, Activity\n2025-01-06, 30m, 3000, Бег\n",
+			want: []TrainingRecord{
+				{Line: 2, Steps: 3000, Activity: "Бег", Duration: 30 * time.Minute},
+			},
+		},
+		{
+			name:  "только заголовок",
+			input: "steps,activity,duration\n",
+			want:  nil,
+		},
+		{
+			name:      "некорректные шаги",
+			input:     "steps,activity,duration\n6000,Бег,1h00m\nabc,Бег,1h00m\n",
+			wantLine:  3,
+			wantField: FieldSteps,
+		},
+		{
+			name:      "некорректная длительность",
+			input:     "steps,activity,duration\n\n6000,Бег,0h00m\n",
+			wantLine:  3,
+			wantField: FieldDuration,
+		},
+		{
+			name:    "пустой файл",
+			input:   "",
+			wantErr: "отсутствует заголовок",
+		},
+		{
+			name:    "нет обязательного столбца",
+			input:   "steps,duration\n6000,1h00m\n",
+			wantErr: `столбец "activity"`,
+		},
+		{
+			name:    "разное количество полей",
+			input:   "steps,activity,duration\n6000,Бег\n",
+			wantErr: "неверный формат CSV",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := ParseTrainingsCSV(strings.NewReader(tt.input))
+
+			if tt.wantField != "" {
+				var parseErr *ParseError
+				assert.True(suite.T(), errors.As(err, &parseErr))
+				assert.Equal(suite.T(), tt.wantLine, parseErr.Line)
+				assert.Equal(suite.T(), tt.wantField, parseErr.Field)
+				assert.Nil(suite.T(), got)
+				return
+			}
+			if tt.wantErr != "" {
+				assert.ErrorContains(suite.T(), err, tt.wantErr)
+				assert.Nil(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+)
+
+// metersPerPedalRev — дистанция за один оборот педалей в метрах
+// для средней передачи шоссейного велосипеда.
+const metersPerPedalRev = 6.0
+
+// Значения MET для велосипеда по скорости согласно Compendium of Physical Activities.
+var cyclingMETBands = []metBand{
+	{maxSpeed: 16, met: 4.0},
+	{maxSpeed: 19, met: 6.8},
+	{maxSpeed: 22, met: 8.0},
+	{maxSpeed: 25, met: 10.0},
+	{maxSpeed: 30, met: 12.0},
+}
+
+// cyclingMETMax — MET для скорости от 30 км/ч.
+const cyclingMETMax = 15.8
+
+func cyclingMET(speedKmH float64) float64 {
+	return metForSpeed(cyclingMETBands, cyclingMETMax, speedKmH)
+}
+
+// cyclingDistance возвращает дистанцию в километрах, пройденную за revs оборотов педалей.
+func cyclingDistance(revs int) float64 {
+	return float64(revs) * metersPerPedalRev / mInKm
+}
+
+// CyclingSpentCalories рассчитывает калории для езды на велосипеде по количеству оборотов педалей:
+// калории = MET * вес * длительность в часах, где MET зависит от средней скорости.
+func CyclingSpentCalories(revs int, weight float64, duration time.Duration) (float64, error) {
+	// Проверка входных параметров
+	if revs <= 0 {
+		return 0, fmt.Errorf("количество оборотов педалей должно быть больше 0")
+	}
+	if revs > maxSteps {
+		return 0, fmt.Errorf("количество оборотов педалей %d превышает допустимое значение %d", revs, maxSteps)
+	}
+	if weight <= 0 {
+		return 0, fmt.Errorf("вес должен быть больше 0")
+	}
+	if duration <= 0 {
+		return 0, ErrNonPositiveDuration
+	}
+
+	// Средняя скорость по дистанции, пройденной за обороты педалей
+	speed := cyclingDistance(revs) / duration.Hours()
+
+	return cyclingMET(speed) * weight * duration.Hours(), nil
+}
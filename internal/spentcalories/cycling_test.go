@@ -0,0 +1,98 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestCyclingSpentCalories() {
+	tests := []struct {
+		name     string
+		revs     int
+		weight   float64
+		duration time.Duration
+		want     float64
+		wantErr  bool
+	}{
+		{
+			name:     "9 км за 20 минут",
+			revs:     1500,
+			weight:   75.0,
+			duration: 20 * time.Minute,
+			want:     300,
+			wantErr:  false,
+		},
+		{
+			name:     "медленная езда",
+			revs:     2000,
+			weight:   80.0,
+			duration: time.Hour,
+			want:     320,
+			wantErr:  false,
+		},
+		{
+			name:     "нулевые обороты",
+			revs:     0,
+			weight:   75.0,
+			duration: time.Hour,
+			wantErr:  true,
+		},
+		{
+			name:     "слишком много оборотов",
+			revs:     maxSteps + 1,
+			weight:   75.0,
+			duration: time.Hour,
+			wantErr:  true,
+		},
+		{
+			name:     "нулевой вес",
+			revs:     1500,
+			weight:   0,
+			duration: time.Hour,
+			wantErr:  true,
+		},
+		{
+			name:     "нулевая длительность",
+			revs:     1500,
+			weight:   75.0,
+			duration: 0,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := CyclingSpentCalories(tt.revs, tt.weight, tt.duration)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoCycling() {
+	want := "Тип тренировки: Велосипед\n" +
+		"Длительность: 0.33 ч.\n" +
+		"Дистанция: 9.00 км.\n" +
+		"Скорость: 27.00 км/ч\n" +
+		"Сожгли калорий: 300.00\n"
+
+	for _, data := range []string{"1500,Велосипед,20m", "1500,Велосипед,0h20m"} {
+		got, err := TrainingInfo(data, 75.0, 1.75)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), want, got)
+	}
+
+	calc, err := CalculatorFor("cycling")
+	assert.NoError(suite.T(), err)
+	assert.IsType(suite.T(), CyclingCalculator{}, calc)
+
+	_, err = TrainingInfo("1500,Плавание,20m", 75.0, 1.75)
+	assert.ErrorContains(suite.T(), err, "неизвестный тип тренировки")
+}
@@ -0,0 +1,38 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+)
+
+// drillMETs — метаболические эквиваленты (MET) для беговых упражнений
+// с движением назад и в сторону. Ключ — название упражнения в нижнем регистре.
+var drillMETs = map[string]float64{
+	"бег спиной вперёд": 7.0,
+	"backpedal":         7.0,
+	"приставной шаг":    6.0,
+	"side shuffle":      6.0,
+	"скрестный шаг":     7.5,
+	"carioca":           7.5,
+	"высокое бедро":     8.0,
+	"high knees":        8.0,
+}
+
+// DrillCalories рассчитывает калории для бегового упражнения по таблице MET:
+// калории = MET * вес * длительность в часах. Для неизвестного упражнения возвращается ошибка.
+func DrillCalories(drill string, weight float64, duration time.Duration) (float64, error) {
+	met, ok := drillMETs[normalizeActivity(drill)]
+	if !ok {
+		return 0, fmt.Errorf("неизвестное упражнение: %s", drill)
+	}
+
+	// Проверка входных параметров
+	if weight <= 0 {
+		return 0, fmt.Errorf("вес должен быть больше 0")
+	}
+	if duration <= 0 {
+		return 0, ErrNonPositiveDuration
+	}
+
+	return met * weight * duration.Hours(), nil
+}
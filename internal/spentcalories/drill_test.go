@@ -0,0 +1,58 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestDrillCalories() {
+	tests := []struct {
+		name     string
+		drill    string
+		duration time.Duration
+		want     float64
+		wantErr  bool
+	}{
+		{
+			name:     "бег спиной вперёд",
+			drill:    "Бег спиной вперёд",
+			duration: 10 * time.Minute,
+			want:     87.5,
+			wantErr:  false,
+		},
+		{
+			name:     "приставной шаг на английском",
+			drill:    " Side  Shuffle ",
+			duration: 20 * time.Minute,
+			want:     150,
+			wantErr:  false,
+		},
+		{
+			name:     "неизвестное упражнение",
+			drill:    "Прыжки",
+			duration: 10 * time.Minute,
+			wantErr:  true,
+		},
+		{
+			name:     "нулевая длительность",
+			drill:    "carioca",
+			duration: 0,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := DrillCalories(tt.drill, 75.0, tt.duration)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+}
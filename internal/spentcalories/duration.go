@@ -0,0 +1,37 @@
+package spentcalories
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// maxBareDuration — наибольшая длительность, которую можно указать числом без единицы измерения.
+// Защищает от опечатки в единицах: при единице time.Hour строка "90" отклоняется, а не означает 90 часов.
+const maxBareDuration = 24 * time.Hour
+
+// ParseDuration разбирает длительность в формате time.ParseDuration.
+// Если задана единица SetBareDurationUnit, дополнительно принимается число без единицы измерения,
+// не превышающее 24 часов.
+func ParseDuration(s string) (time.Duration, error) {
+	duration, err := time.ParseDuration(s)
+	unit := currentSettings().bareDurationUnit
+	if err == nil || unit == 0 {
+		return duration, err
+	}
+
+	// Пробуем разобрать строку как число в заданных единицах
+	value, parseErr := strconv.ParseFloat(s, 64)
+	if parseErr != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, err
+	}
+
+	// Проверяем, что длительность правдоподобна и помещается в time.Duration
+	result := value * float64(unit)
+	if math.Abs(result) > float64(maxBareDuration) {
+		return 0, fmt.Errorf("длительность %q в единицах %s превышает %s", s, unit, maxBareDuration)
+	}
+
+	return time.Duration(result), nil
+}
@@ -0,0 +1,148 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestParseDuration() {
+	tests := []struct {
+		name    string
+		unit    time.Duration
+		input   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{
+			name:    "стандартный формат",
+			unit:    0,
+			input:   "1h30m",
+			want:    90 * time.Minute,
+			wantErr: false,
+		},
+		{
+			name:    "число без единицы запрещено по умолчанию",
+			unit:    0,
+			input:   "1.5",
+			wantErr: true,
+		},
+		{
+			name:    "дробное число часов",
+			unit:    time.Hour,
+			input:   "1.5",
+			want:    90 * time.Minute,
+			wantErr: false,
+		},
+		{
+			name:    "число без единицы запрещено по умолчанию - целое",
+			unit:    0,
+			input:   "90",
+			wantErr: true,
+		},
+		{
+			name:    "неправдоподобное число часов",
+			unit:    time.Hour,
+			input:   "90",
+			wantErr: true,
+		},
+		{
+			name:    "сутки в часах",
+			unit:    time.Hour,
+			input:   "24",
+			want:    24 * time.Hour,
+			wantErr: false,
+		},
+		{
+			name:    "целое число минут",
+			unit:    time.Minute,
+			input:   "90",
+			want:    90 * time.Minute,
+			wantErr: false,
+		},
+		{
+			name:    "больше суток в минутах",
+			unit:    time.Minute,
+			input:   "1441",
+			wantErr: true,
+		},
+		{
+			name:    "стандартный формат при заданной единице",
+			unit:    time.Hour,
+			input:   "1h30m",
+			want:    90 * time.Minute,
+			wantErr: false,
+		},
+		{
+			name:    "некорректная строка",
+			unit:    time.Hour,
+			input:   "1.5x",
+			wantErr: true,
+		},
+		{
+			name:    "бесконечность",
+			unit:    time.Hour,
+			input:   "Inf",
+			wantErr: true,
+		},
+		{
+			name:    "переполнение",
+			unit:    time.Hour,
+			input:   "1e30",
+			wantErr: true,
+		},
+	}
+
+	defer func() {
+		_ = SetBareDurationUnit(0)
+	}()
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.NoError(suite.T(), SetBareDurationUnit(tt.unit))
+
+			got, err := ParseDuration(tt.input)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Equal(suite.T(), time.Duration(0), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestParseTrainingBareDuration() {
+	defer func() {
+		_ = SetBareDurationUnit(0)
+	}()
+
+	assert.Error(suite.T(), SetBareDurationUnit(-time.Hour))
+	assert.NoError(suite.T(), SetBareDurationUnit(time.Hour))
+
+	steps, activity, duration, err := parseTraining("3456,Ходьба,1.5")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3456, steps)
+	assert.Equal(suite.T(), "Ходьба", activity)
+	assert.Equal(suite.T(), 90*time.Minute, duration)
+
+	_, _, _, err = parseTraining("3456,Ходьба,-1.5")
+	assert.Error(suite.T(), err)
+
+	_, _, _, err = parseTraining("3456,Ходьба,90")
+	assert.Error(suite.T(), err)
+
+	// Для записей в минутах включаем разбор числа как минут
+	assert.NoError(suite.T(), SetBareDurationUnit(time.Minute))
+	_, _, duration, err = parseTraining("3456,Ходьба,90")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 90*time.Minute, duration)
+
+	// По умолчанию число без единицы измерения не принимается
+	assert.NoError(suite.T(), SetBareDurationUnit(0))
+	_, _, _, err = parseTraining("3456,Ходьба,90")
+	assert.Error(suite.T(), err)
+}
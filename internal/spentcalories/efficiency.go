@@ -0,0 +1,34 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+)
+
+// StepsPerCalorie возвращает количество шагов, приходящееся на одну сожжённую калорию.
+func StepsPerCalorie(steps int, weight, height float64, duration time.Duration, activity string) (float64, error) {
+	calories, err := spentCalories(activity, steps, weight, height, duration)
+	if err != nil {
+		return 0, err
+	}
+
+	// Защищаемся от деления на ноль
+	if calories == 0 {
+		return 0, fmt.Errorf("количество калорий равно нулю")
+	}
+
+	return float64(steps) / calories, nil
+}
+
+// referenceWeight — стандартный вес в килограммах для сравнения калорий разных людей.
+const referenceWeight = 70.0
+
+// NormalizedTo70kg пересчитывает калории так, как если бы пользователь весил 70 кг.
+// Расход энергии в формулах пропорционален весу, поэтому калории масштабируются линейно.
+func NormalizedTo70kg(calories, weight float64) (float64, error) {
+	if weight <= 0 {
+		return 0, fmt.Errorf("вес должен быть больше 0")
+	}
+
+	return calories * referenceWeight / weight, nil
+}
@@ -0,0 +1,32 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestStepsPerCalorie() {
+	got, err := StepsPerCalorie(6000, 75.0, 1.75, time.Hour, "Ходьба")
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 33.86, got, 0.01)
+
+	_, err = StepsPerCalorie(6000, 75.0, 1.75, time.Hour, "Плавание")
+	assert.Error(suite.T(), err)
+
+	_, err = StepsPerCalorie(6000, 75.0, 1.75, 0, "Ходьба")
+	assert.Error(suite.T(), err)
+}
+
+func (suite *SpentCaloriesTestSuite) TestNormalizedTo70kg() {
+	got, err := NormalizedTo70kg(400, 80)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 350, got, 1e-9)
+
+	got, err = NormalizedTo70kg(400, 70)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 400, got, 1e-9)
+
+	_, err = NormalizedTo70kg(400, 0)
+	assert.Error(suite.T(), err)
+}
@@ -0,0 +1,12 @@
+package spentcalories
+
+import "errors"
+
+// Ошибки проверки входных данных. Возвращаемые ошибки оборачивают их,
+// поэтому причину можно определить через errors.Is, не сравнивая текст.
+var (
+	ErrInvalidFormat       = errors.New("неверный формат данных")
+	ErrNonPositiveSteps    = errors.New("количество шагов должно быть больше 0")
+	ErrNonPositiveDuration = errors.New("длительность должна быть больше 0")
+	ErrUnknownActivity     = errors.New("неизвестный тип тренировки")
+)
@@ -0,0 +1,60 @@
+package spentcalories
+
+import (
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestSentinelErrors() {
+	tests := []struct {
+		name  string
+		input string
+		want  error
+	}{
+		{
+			name:  "неверное количество полей",
+			input: "6000,Бег",
+			want:  ErrInvalidFormat,
+		},
+		{
+			name:  "нулевые шаги",
+			input: "0,Бег,1h00m",
+			want:  ErrNonPositiveSteps,
+		},
+		{
+			name:  "нулевая длительность",
+			input: "6000,Бег,0h00m",
+			want:  ErrNonPositiveDuration,
+		},
+		{
+			name:  "неизвестная активность",
+			input: "6000,Плавание,1h00m",
+			want:  ErrUnknownActivity,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			_, err := TrainingInfo(tt.input, 75.0, 1.75)
+			assert.True(suite.T(), errors.Is(err, tt.want), "ошибка: %v", err)
+
+			// Разбор записи возвращает те же причины ошибок
+			if tt.want != ErrUnknownActivity {
+				_, _, _, err = parseTraining(tt.input)
+				assert.True(suite.T(), errors.Is(err, tt.want), "ошибка: %v", err)
+			}
+		})
+	}
+
+	_, err := RunningSpentCalories(0, 75.0, 1.75, time.Hour)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveSteps)
+
+	_, err = WalkingSpentCalories(6000, 75.0, 1.75, 0)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveDuration)
+
+	_, err = CalculatorFor("йога")
+	assert.ErrorIs(suite.T(), err, ErrUnknownActivity)
+	assert.EqualError(suite.T(), err, "неизвестный тип тренировки: йога")
+}
@@ -0,0 +1,25 @@
+package spentcalories
+
+import "strings"
+
+// splitTraining делит запись "шаги,активность,длительность" на три поля без strings.Split,
+// чтобы разбор корректной записи не выделял память. ok == false, если полей не три.
+func splitTraining(data string) (stepsRaw, activityRaw, durationRaw string, ok bool) {
+	first := strings.IndexByte(data, ',')
+	if first < 0 {
+		return "", "", "", false
+	}
+
+	rest := data[first+1:]
+	second := strings.IndexByte(rest, ',')
+	if second < 0 {
+		return "", "", "", false
+	}
+
+	durationRaw = rest[second+1:]
+	if strings.IndexByte(durationRaw, ',') >= 0 {
+		return "", "", "", false
+	}
+
+	return data[:first], rest[:second], durationRaw, true
+}
@@ -0,0 +1,118 @@
+package spentcalories
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var parseTrainingInputs = []string{
+	"3456,Ходьба,3h00m",
+	"678,Бег,5m",
+	"+12345,Ходьба,1h30m",
+	" 1000 , Бег , 30m ",
+	"1000,Ходьба,30.5m",
+	"678,Ходьба",
+	"678,Ходьба,1h30m,extra",
+	"",
+	",,",
+	"abc,Ходьба,1h30m",
+	"0,Ходьба,1h30m",
+	"-100,Ходьба,1h30m",
+	"678, ,1h30m",
+	"678,Ходьба,invalid",
+	"678,Бег,0h0m",
+	"678,Ходьба,-1h30m",
+	"678,Ходьба,30",
+}
+
+// parseTrainingSplit — исходный разбор записи через strings.Split, с которым
+// сравнивается разбор без выделения памяти.
+func parseTrainingSplit(data string) (int, string, time.Duration, error) {
+	parts := strings.Split(data, ",")
+	if len(parts) != 3 {
+		return 0, "", 0, newParseError(FieldRecord, data, fmt.Errorf("%w, ожидается 'шаги,активность,длительность'", ErrInvalidFormat))
+	}
+
+	return parseTrainingFields(parts[0], parts[1], parts[2])
+}
+
+func (suite *SpentCaloriesTestSuite) TestParseTrainingMatchesSplit() {
+	for _, input := range parseTrainingInputs {
+		suite.Run(input, func() {
+			wantSteps, wantActivity, wantDuration, wantErr := parseTrainingSplit(input)
+			gotSteps, gotActivity, gotDuration, gotErr := parseTraining(input)
+
+			assert.Equal(suite.T(), wantErr, gotErr)
+			assert.Equal(suite.T(), wantSteps, gotSteps)
+			assert.Equal(suite.T(), wantActivity, gotActivity)
+			assert.Equal(suite.T(), wantDuration, gotDuration)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestParseTrainingAllocations() {
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _, _, _ = parseTraining("3456,Ходьба,3h00m")
+	})
+	assert.Equal(suite.T(), 0.0, allocs)
+}
+
+func (suite *SpentCaloriesTestSuite) TestParseTrainingDetailedError() {
+	// Ошибка сразу содержит поле и исходное значение
+	_, _, _, err := parseTraining("abc,Ходьба,1h30m")
+
+	var parseErr *ParseError
+	assert.ErrorAs(suite.T(), err, &parseErr)
+	assert.Equal(suite.T(), FieldSteps, parseErr.Field)
+	assert.Equal(suite.T(), "abc", parseErr.Raw)
+}
+
+func FuzzParseTrainingSplit(f *testing.F) {
+	for _, input := range parseTrainingInputs {
+		f.Add(input)
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		wantSteps, wantActivity, wantDuration, wantErr := parseTrainingSplit(input)
+		gotSteps, gotActivity, gotDuration, gotErr := parseTraining(input)
+
+		if fmt.Sprint(wantErr) != fmt.Sprint(gotErr) {
+			t.Fatalf("ошибки различаются для %q: %v и %v", input, wantErr, gotErr)
+		}
+		if wantSteps != gotSteps || wantActivity != gotActivity || wantDuration != gotDuration {
+			t.Fatalf("результаты различаются для %q", input)
+		}
+	})
+}
+
+func BenchmarkParseTraining(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _, _, _ = parseTraining("3456,Ходьба,3h00m")
+	}
+}
+
+func BenchmarkParseTrainingSplit(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _, _, _ = parseTrainingSplit("3456,Ходьба,3h00m")
+	}
+}
+
+func BenchmarkParseTrainingInvalid(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _, _, _ = parseTraining("0,Ходьба,3h00m")
+	}
+}
+
+func BenchmarkParseTrainingSplitInvalid(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _, _, _ = parseTrainingSplit("0,Ходьба,3h00m")
+	}
+}
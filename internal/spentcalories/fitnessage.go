@@ -0,0 +1,46 @@
+package spentcalories
+
+import (
+	"fmt"
+	"math"
+)
+
+// Параметры упрощённой модели «фитнес-возраста».
+const (
+	referenceRestingHR     = 70          // пульс в покое, не меняющий оценку, уд/мин.
+	fitnessYearsPerBeat    = 0.5         // лет на каждый удар пульса в покое выше или ниже опорного.
+	referenceWeeklyMinutes = 150         // рекомендуемая недельная активность в минутах.
+	activityMinutesPerYear = 30          // минут недельной активности на один год разницы.
+	maxActivityAdjustment  = 10          // наибольшая поправка за активность в годах.
+	minRestingHR           = 30          // минимальный допустимый пульс в покое.
+	maxRestingHR           = 120         // максимальный допустимый пульс в покое.
+	minutesInWeek          = 7 * 24 * 60 // количество минут в неделе.
+)
+
+// FitnessAge оценивает «фитнес-возраст» по пульсу в покое и недельной активности.
+// Каждый удар пульса в покое ниже 70 уд/мин уменьшает оценку на полгода, а каждые
+// 30 минут активности сверх рекомендуемых 150 минут в неделю — на год (не более чем
+// на 10 лет). Высокий пульс и недостаток активности, наоборот, увеличивают оценку.
+func FitnessAge(restingHR int, weeklyActiveMinutes int, actualAge int) (int, error) {
+	// Проверка входных параметров
+	if restingHR < minRestingHR || restingHR > maxRestingHR {
+		return 0, fmt.Errorf("пульс в покое должен быть в диапазоне от %d до %d уд/мин", minRestingHR, maxRestingHR)
+	}
+	if weeklyActiveMinutes < 0 || weeklyActiveMinutes > minutesInWeek {
+		return 0, fmt.Errorf("недельная активность должна быть в диапазоне от 0 до %d минут", minutesInWeek)
+	}
+	if actualAge <= 0 || actualAge > 120 {
+		return 0, fmt.Errorf("возраст должен быть в диапазоне от 1 до 120 лет")
+	}
+
+	// Поправка за пульс в покое
+	hrAdjustment := fitnessYearsPerBeat * float64(restingHR-referenceRestingHR)
+
+	// Поправка за недельную активность, ограниченная сверху и снизу
+	activityAdjustment := float64(referenceWeeklyMinutes-weeklyActiveMinutes) / activityMinutesPerYear
+	activityAdjustment = math.Max(-maxActivityAdjustment, math.Min(maxActivityAdjustment, activityAdjustment))
+
+	age := math.Round(float64(actualAge) + hrAdjustment + activityAdjustment)
+
+	return max(1, int(age)), nil
+}
@@ -0,0 +1,84 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestFitnessAge() {
+	tests := []struct {
+		name      string
+		restingHR int
+		minutes   int
+		age       int
+		want      int
+		wantErr   bool
+	}{
+		{
+			name:      "тренированный человек моложе своего возраста",
+			restingHR: 50,
+			minutes:   300,
+			age:       40,
+			want:      25,
+			wantErr:   false,
+		},
+		{
+			name:      "опорные значения",
+			restingHR: 70,
+			minutes:   150,
+			age:       40,
+			want:      40,
+			wantErr:   false,
+		},
+		{
+			name:      "малоподвижный образ жизни",
+			restingHR: 80,
+			minutes:   0,
+			age:       40,
+			want:      50,
+			wantErr:   false,
+		},
+		{
+			name:      "поправка за активность ограничена",
+			restingHR: 70,
+			minutes:   1000,
+			age:       40,
+			want:      30,
+			wantErr:   false,
+		},
+		{
+			name:      "некорректный пульс",
+			restingHR: 10,
+			minutes:   150,
+			age:       40,
+			wantErr:   true,
+		},
+		{
+			name:      "отрицательная активность",
+			restingHR: 70,
+			minutes:   -1,
+			age:       40,
+			wantErr:   true,
+		},
+		{
+			name:      "некорректный возраст",
+			restingHR: 70,
+			minutes:   150,
+			age:       0,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := FitnessAge(tt.restingHR, tt.minutes, tt.age)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package spentcalories
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Yandex-Practicum/tracker/internal/units"
+)
+
+// Разделители целой и дробной части числа.
+const (
+	DecimalPoint = "." // точка, используется по умолчанию.
+	DecimalComma = "," // запятая, принята в русскоязычных отчётах.
+)
+
+// FormatOptions задаёт количество знаков после запятой, разделитель дробной части,
+// язык и единицы измерения текстовых отчётов.
+//
+// Нулевая точность означает ноль знаков после запятой, поэтому нулевое значение
+// FormatOptions форматирует числа как целые. Чтобы изменить только часть настроек,
+// начните с DefaultFormatOptions и переопределите нужные поля.
+type FormatOptions struct {
+	DistancePrecision int          // знаков после запятой для дистанции.
+	SpeedPrecision    int          // знаков после запятой для скорости.
+	CaloriesPrecision int          // знаков после запятой для калорий.
+	DecimalSeparator  string       // разделитель дробной части; пустая строка означает точку.
+	Locale            Locale       // язык отчётов; пустая строка означает русский.
+	Units             units.System // единицы дистанции и скорости; пустая строка означает метрические.
+}
+
+// DefaultFormatOptions возвращает настройки форматирования по умолчанию — два знака после запятой.
+func DefaultFormatOptions() FormatOptions {
+	return FormatOptions{
+		DistancePrecision: 2,
+		SpeedPrecision:    2,
+		CaloriesPrecision: 2,
+		DecimalSeparator:  DecimalPoint,
+		Locale:            LocaleRu,
+		Units:             units.Metric,
+	}
+}
+
+// Validate проверяет, что точность не отрицательна, разделитель — точка или запятая,
+// а язык отчётов и система измерения поддерживаются.
+func (o FormatOptions) Validate() error {
+	if o.DistancePrecision < 0 || o.SpeedPrecision < 0 || o.CaloriesPrecision < 0 {
+		return fmt.Errorf("количество знаков после запятой не может быть отрицательным")
+	}
+
+	switch o.DecimalSeparator {
+	case "", DecimalPoint, DecimalComma:
+	default:
+		return fmt.Errorf("неизвестный разделитель дробной части: %q", o.DecimalSeparator)
+	}
+
+	switch o.Locale {
+	case "", LocaleRu, LocaleEn:
+	default:
+		return fmt.Errorf("неподдерживаемый язык отчётов: %q", o.Locale)
+	}
+
+	return o.Units.Validate()
+}
+
+// FormatFloat форматирует число с precision знаками после запятой
+// и разделителем дробной части из настроек.
+func (o FormatOptions) FormatFloat(v float64, precision int) string {
+	s := strconv.FormatFloat(v, 'f', precision, 64)
+
+	if o.DecimalSeparator == DecimalComma {
+		s = strings.Replace(s, DecimalPoint, DecimalComma, 1)
+	}
+
+	return s
+}
+
+// Format форматирует результат тренировки с заданной точностью, разделителем дробной части,
+// на языке и в единицах измерения из настроек.
+func (t Training) Format(opts FormatOptions) string {
+	locale := opts.LocaleOrDefault()
+	messages := trainingCatalog[locale]
+
+	return fmt.Sprintf(
+		messages.report,
+		messages.activityName(t.Activity),
+		opts.FormatFloat(t.Duration.Hours(), 2),
+		opts.FormatFloat(opts.Units.FromKm(t.Distance), opts.DistancePrecision),
+		opts.Units.DistanceLabel(string(locale)),
+		opts.FormatFloat(opts.Units.FromKm(t.Speed), opts.SpeedPrecision),
+		opts.Units.SpeedLabel(string(locale)),
+		opts.FormatFloat(t.Calories, opts.CaloriesPrecision),
+	)
+}
+
+// TrainingInfoFormat работает как TrainingInfo, но форматирует числа с заданной точностью.
+func TrainingInfoFormat(data string, weight, height float64, opts FormatOptions) (string, error) {
+	if err := opts.Validate(); err != nil {
+		return "", err
+	}
+
+	result, err := trainingInfo(data, weight, height)
+	if err != nil {
+		return "", err
+	}
+
+	return result.Format(opts), nil
+}
+
+// TrainingInfoKVMap рассчитывает показатели тренировки и возвращает их в виде строк
+// с единицами измерения. Ключи: "activity", "steps", "duration", "distance", "speed", "calories".
+func TrainingInfoKVMap(data string, weight, height float64) (map[string]string, error) {
+	result, err := trainingInfo(data, weight, height)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := DefaultFormatOptions()
+
+	return map[string]string{
+		"activity": result.Activity,
+		"steps":    fmt.Sprintf("%d", result.Steps),
+		"duration": fmt.Sprintf("%.2f ч.", result.Duration.Hours()),
+		"distance": fmt.Sprintf("%.*f км", opts.DistancePrecision, result.Distance),
+		"speed":    fmt.Sprintf("%.*f км/ч", opts.SpeedPrecision, result.Speed),
+		"calories": fmt.Sprintf("%.*f ккал", opts.CaloriesPrecision, result.Calories),
+	}, nil
+}
@@ -0,0 +1,107 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoFormat() {
+	tests := []struct {
+		name    string
+		input   string
+		opts    FormatOptions
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "настройки по умолчанию",
+			input:   "6000,Ходьба,1h00m",
+			opts:    DefaultFormatOptions(),
+			want:    "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\nСкорость: 4.72 км/ч\nСожгли калорий: 177.19\n",
+			wantErr: false,
+		},
+		{
+			name:    "целые калории и три знака для дистанции",
+			input:   "6000,Бег,1h00m",
+			opts:    FormatOptions{DistancePrecision: 3, SpeedPrecision: 1, CaloriesPrecision: 0},
+			want:    "Тип тренировки: Бег\nДлительность: 1.00 ч.\nДистанция: 4.725 км.\nСкорость: 4.7 км/ч\nСожгли калорий: 354\n",
+			wantErr: false,
+		},
+		{
+			name:    "нулевое значение форматирует целые числа",
+			input:   "6000,Бег,1h00m",
+			opts:    FormatOptions{},
+			want:    "Тип тренировки: Бег\nДлительность: 1.00 ч.\nДистанция: 5 км.\nСкорость: 5 км/ч\nСожгли калорий: 354\n",
+			wantErr: false,
+		},
+		{
+			name:  "настройки по умолчанию с переопределённой точностью",
+			input: "6000,Бег,1h00m",
+			opts: func() FormatOptions {
+				opts := DefaultFormatOptions()
+				opts.CaloriesPrecision = 0
+				return opts
+			}(),
+			want:    "Тип тренировки: Бег\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\nСкорость: 4.72 км/ч\nСожгли калорий: 354\n",
+			wantErr: false,
+		},
+		{
+			name:    "запятая в качестве разделителя",
+			input:   "6000,Бег,1h30m",
+			opts:    FormatOptions{DistancePrecision: 2, SpeedPrecision: 2, CaloriesPrecision: 2, DecimalSeparator: DecimalComma},
+			want:    "Тип тренировки: Бег\nДлительность: 1,50 ч.\nДистанция: 4,72 км.\nСкорость: 3,15 км/ч\nСожгли калорий: 354,38\n",
+			wantErr: false,
+		},
+		{
+			name:    "отрицательная точность",
+			input:   "6000,Бег,1h00m",
+			opts:    FormatOptions{DistancePrecision: -1},
+			wantErr: true,
+		},
+		{
+			name:    "неизвестный разделитель",
+			input:   "6000,Бег,1h00m",
+			opts:    FormatOptions{DecimalSeparator: ";"},
+			wantErr: true,
+		},
+		{
+			name:    "некорректные данные",
+			input:   "6000,Бег",
+			opts:    DefaultFormatOptions(),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := TrainingInfoFormat(tt.input, 75.0, 1.75, tt.opts)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Empty(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoKVMap() {
+	got, err := TrainingInfoKVMap("6000,Бег,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	keys := make([]string, 0, len(got))
+	for k := range got {
+		keys = append(keys, k)
+	}
+	assert.ElementsMatch(suite.T(), []string{"activity", "steps", "duration", "distance", "speed", "calories"}, keys)
+
+	assert.Equal(suite.T(), "Бег", got["activity"])
+	assert.Equal(suite.T(), "4.72 км", got["distance"])
+	assert.Equal(suite.T(), "354.38 ккал", got["calories"])
+
+	got, err = TrainingInfoKVMap("6000,Плавание,1h00m", 75.0, 1.75)
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), got)
+}
@@ -0,0 +1,50 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+)
+
+// RunningSpentCaloriesDistance рассчитывает калории для бега по дистанции distanceKm,
+// измеренной устройством (например, по GPS). Скорость вычисляется из переданной
+// дистанции. Если distanceKm не больше 0, используется оценка по шагам и росту,
+// как в RunningSpentCalories.
+func RunningSpentCaloriesDistance(distanceKm float64, steps int, weight, height float64, duration time.Duration) (float64, error) {
+	if distanceKm <= 0 {
+		return RunningSpentCalories(steps, weight, height, duration)
+	}
+
+	return caloriesForDistance(distanceKm, weight, duration)
+}
+
+// WalkingSpentCaloriesDistance рассчитывает калории для ходьбы по дистанции distanceKm,
+// измеренной устройством. Если distanceKm не больше 0, используется оценка по шагам
+// и росту, как в WalkingSpentCalories.
+func WalkingSpentCaloriesDistance(distanceKm float64, steps int, weight, height float64, duration time.Duration) (float64, error) {
+	if distanceKm <= 0 {
+		return WalkingSpentCalories(steps, weight, height, duration)
+	}
+
+	calories, err := caloriesForDistance(distanceKm, weight, duration)
+	if err != nil {
+		return 0, err
+	}
+
+	return calories * currentSettings().walkingCoefficient, nil
+}
+
+func caloriesForDistance(distanceKm, weight float64, duration time.Duration) (float64, error) {
+	// Проверка входных параметров
+	if weight <= 0 {
+		return 0, fmt.Errorf("вес должен быть больше 0")
+	}
+	if duration <= 0 {
+		return 0, ErrNonPositiveDuration
+	}
+
+	// Рассчитываем среднюю скорость по переданной дистанции
+	speed := distanceKm / duration.Hours()
+
+	// Рассчитываем калории по той же формуле, что и для шагов
+	return (weight * speed * duration.Minutes()) / minInH, nil
+}
@@ -0,0 +1,79 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestSpentCaloriesDistance() {
+	tests := []struct {
+		name       string
+		distanceKm float64
+		steps      int
+		duration   time.Duration
+		wantRun    float64
+		wantWalk   float64
+		wantErr    bool
+	}{
+		{
+			name:       "дистанция по GPS",
+			distanceKm: 5.0,
+			steps:      6000,
+			duration:   time.Hour,
+			wantRun:    375.0,
+			wantWalk:   187.5,
+			wantErr:    false,
+		},
+		{
+			name:       "GPS-дистанция без шагов",
+			distanceKm: 5.0,
+			steps:      0,
+			duration:   30 * time.Minute,
+			wantRun:    375.0,
+			wantWalk:   187.5,
+			wantErr:    false,
+		},
+		{
+			name:       "нулевая дистанция - оценка по шагам",
+			distanceKm: 0,
+			steps:      6000,
+			duration:   time.Hour,
+			wantRun:    354.375,
+			wantWalk:   177.1875,
+			wantErr:    false,
+		},
+		{
+			name:       "отрицательная дистанция без шагов",
+			distanceKm: -1,
+			steps:      0,
+			duration:   time.Hour,
+			wantErr:    true,
+		},
+		{
+			name:       "нулевая длительность",
+			distanceKm: 5.0,
+			steps:      6000,
+			duration:   0,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			run, err := RunningSpentCaloriesDistance(tt.distanceKm, tt.steps, 75.0, 1.75, tt.duration)
+			walk, walkErr := WalkingSpentCaloriesDistance(tt.distanceKm, tt.steps, 75.0, 1.75, tt.duration)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Error(suite.T(), walkErr)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.NoError(suite.T(), walkErr)
+			assert.InDelta(suite.T(), tt.wantRun, run, 1e-9)
+			assert.InDelta(suite.T(), tt.wantWalk, walk, 1e-9)
+		})
+	}
+}
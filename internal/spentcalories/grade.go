@@ -0,0 +1,86 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+)
+
+// Константы для учёта уклона трассы.
+const (
+	maxGradePercent          = 45.0 // максимальный допустимый уклон в процентах.
+	uphillGradeCoefficient   = 0.06 // прирост расхода калорий на каждый процент подъёма.
+	downhillGradeCoefficient = 0.02 // снижение расхода калорий на каждый процент спуска.
+	minGradeMultiplier       = 0.8  // нижняя граница множителя при спуске.
+
+	gravity             = 9.81 // ускорение свободного падения в м/с².
+	joulesInKcal        = 4184 // количество джоулей в килокалории.
+	eccentricWorkFactor = 0.25 // доля потенциальной энергии, расходуемая мышцами при спуске.
+)
+
+// gradeMultiplier возвращает множитель расхода калорий для уклона в процентах.
+func gradeMultiplier(gradePercent float64) float64 {
+	// На подъёме расход растёт линейно
+	if gradePercent >= 0 {
+		return 1 + uphillGradeCoefficient*gradePercent
+	}
+
+	// На спуске расход немного снижается, но не ниже минимального значения
+	multiplier := 1 + downhillGradeCoefficient*gradePercent
+	if multiplier < minGradeMultiplier {
+		multiplier = minGradeMultiplier
+	}
+
+	return multiplier
+}
+
+func validateGrade(gradePercent float64) error {
+	if gradePercent < -maxGradePercent || gradePercent > maxGradePercent {
+		return fmt.Errorf("уклон должен быть в диапазоне от %.0f%% до %.0f%%", -maxGradePercent, maxGradePercent)
+	}
+	return nil
+}
+
+// RunningSpentCaloriesGrade рассчитывает калории при беге с учётом уклона трассы в процентах.
+// При нулевом уклоне результат совпадает с RunningSpentCalories.
+func RunningSpentCaloriesGrade(steps int, weight, height float64, duration time.Duration, gradePercent float64) (float64, error) {
+	// Проверяем уклон
+	if err := validateGrade(gradePercent); err != nil {
+		return 0, err
+	}
+
+	calories, err := RunningSpentCalories(steps, weight, height, duration)
+	if err != nil {
+		return 0, err
+	}
+
+	return calories * gradeMultiplier(gradePercent), nil
+}
+
+// WalkingSpentCaloriesGrade рассчитывает калории при ходьбе с учётом уклона трассы в процентах.
+// При нулевом уклоне результат совпадает с WalkingSpentCalories.
+func WalkingSpentCaloriesGrade(steps int, weight, height float64, duration time.Duration, gradePercent float64) (float64, error) {
+	// Проверяем уклон
+	if err := validateGrade(gradePercent); err != nil {
+		return 0, err
+	}
+
+	calories, err := WalkingSpentCalories(steps, weight, height, duration)
+	if err != nil {
+		return 0, err
+	}
+
+	return calories * gradeMultiplier(gradePercent), nil
+}
+
+// DownhillCalories оценивает калории, затраченные на эксцентрическую работу мышц
+// при спуске на descentM метров. Результат никогда не бывает отрицательным.
+func DownhillCalories(weight, descentM float64) float64 {
+	if weight <= 0 || descentM <= 0 {
+		return 0
+	}
+
+	// Потенциальная энергия спуска в джоулях
+	energy := weight * gravity * descentM
+
+	return energy * eccentricWorkFactor / joulesInKcal
+}
@@ -0,0 +1,155 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestSpentCaloriesGrade() {
+	tests := []struct {
+		name         string
+		steps        int
+		weight       float64
+		height       float64
+		duration     time.Duration
+		gradePercent float64
+		wantRunCal   float64
+		wantWalkCal  float64
+		wantErr      bool
+	}{
+		{
+			name:         "нулевой уклон",
+			steps:        6000,
+			weight:       75.0,
+			height:       1.75,
+			duration:     1 * time.Hour,
+			gradePercent: 0,
+			wantRunCal:   354.375,
+			wantWalkCal:  177.1875,
+			wantErr:      false,
+		},
+		{
+			name:         "подъём 5%",
+			steps:        6000,
+			weight:       75.0,
+			height:       1.75,
+			duration:     1 * time.Hour,
+			gradePercent: 5,
+			wantRunCal:   460.6875,
+			wantWalkCal:  230.34375,
+			wantErr:      false,
+		},
+		{
+			name:         "спуск 5%",
+			steps:        6000,
+			weight:       75.0,
+			height:       1.75,
+			duration:     1 * time.Hour,
+			gradePercent: -5,
+			wantRunCal:   318.9375,
+			wantWalkCal:  159.46875,
+			wantErr:      false,
+		},
+		{
+			name:         "крутой спуск - ограничение множителя",
+			steps:        6000,
+			weight:       75.0,
+			height:       1.75,
+			duration:     1 * time.Hour,
+			gradePercent: -40,
+			wantRunCal:   283.5,
+			wantWalkCal:  141.75,
+			wantErr:      false,
+		},
+		{
+			name:         "слишком большой уклон",
+			steps:        6000,
+			weight:       75.0,
+			height:       1.75,
+			duration:     1 * time.Hour,
+			gradePercent: 50,
+			wantErr:      true,
+		},
+		{
+			name:         "некорректные шаги",
+			steps:        0,
+			weight:       75.0,
+			height:       1.75,
+			duration:     1 * time.Hour,
+			gradePercent: 5,
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			gotRun, errRun := RunningSpentCaloriesGrade(tt.steps, tt.weight, tt.height, tt.duration, tt.gradePercent)
+			gotWalk, errWalk := WalkingSpentCaloriesGrade(tt.steps, tt.weight, tt.height, tt.duration, tt.gradePercent)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), errRun)
+				assert.Error(suite.T(), errWalk)
+				assert.Equal(suite.T(), 0.0, gotRun)
+				assert.Equal(suite.T(), 0.0, gotWalk)
+				return
+			}
+
+			assert.NoError(suite.T(), errRun)
+			assert.NoError(suite.T(), errWalk)
+			assert.InDelta(suite.T(), tt.wantRunCal, gotRun, 0.001)
+			assert.InDelta(suite.T(), tt.wantWalkCal, gotWalk, 0.001)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestSpentCaloriesGradeZeroMatchesFlat() {
+	flat, err := RunningSpentCalories(3000, 75.0, 1.75, 30*time.Minute)
+	assert.NoError(suite.T(), err)
+
+	graded, err := RunningSpentCaloriesGrade(3000, 75.0, 1.75, 30*time.Minute, 0)
+	assert.NoError(suite.T(), err)
+
+	assert.Equal(suite.T(), flat, graded)
+}
+
+func (suite *SpentCaloriesTestSuite) TestDownhillCalories() {
+	tests := []struct {
+		name     string
+		weight   float64
+		descentM float64
+		wantCal  float64
+	}{
+		{
+			name:     "спуск 200 м",
+			weight:   75.0,
+			descentM: 200,
+			wantCal:  8.79,
+		},
+		{
+			name:     "нет спуска",
+			weight:   75.0,
+			descentM: 0,
+			wantCal:  0,
+		},
+		{
+			name:     "отрицательный спуск",
+			weight:   75.0,
+			descentM: -200,
+			wantCal:  0,
+		},
+		{
+			name:     "нулевой вес",
+			weight:   0,
+			descentM: 200,
+			wantCal:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got := DownhillCalories(tt.weight, tt.descentM)
+			assert.InDelta(suite.T(), tt.wantCal, got, 0.01)
+		})
+	}
+}
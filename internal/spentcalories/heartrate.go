@@ -0,0 +1,272 @@
+package spentcalories
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Константы для расчетов по пульсу.
+const (
+	restingVO2     = 3.5  // потребление кислорода в покое, мл/кг/мин.
+	referenceVO2   = 35.0 // условное максимальное потребление кислорода, мл/кг/мин.
+	kcalPerLiterO2 = 5.0  // количество килокалорий на литр потреблённого кислорода.
+	mlInL          = 1000 // количество миллилитров в литре.
+)
+
+// HRReserveCalories рассчитывает калории по резерву пульса (метод Карвонена):
+// доля резерва (avgHR-restingHR)/(maxHR-restingHR) определяет долю резерва потребления кислорода.
+func HRReserveCalories(avgHR, restingHR, maxHR int, weight float64, duration time.Duration) (float64, error) {
+	// Проверка входных параметров
+	if restingHR <= 0 {
+		return 0, fmt.Errorf("пульс в покое должен быть больше 0")
+	}
+	if restingHR >= maxHR {
+		return 0, fmt.Errorf("максимальный пульс должен быть больше пульса в покое")
+	}
+	if avgHR < restingHR || avgHR > maxHR {
+		return 0, fmt.Errorf("средний пульс должен быть между пульсом в покое и максимальным")
+	}
+	if weight <= 0 {
+		return 0, fmt.Errorf("вес должен быть больше 0")
+	}
+	if duration <= 0 {
+		return 0, ErrNonPositiveDuration
+	}
+
+	// Доля использованного резерва пульса
+	reserve := float64(avgHR-restingHR) / float64(maxHR-restingHR)
+
+	// Потребление кислорода растёт пропорционально доле резерва
+	vo2 := restingVO2 + reserve*(referenceVO2-restingVO2)
+
+	// Переводим потребление кислорода в калории
+	caloriesPerMinute := vo2 * weight / mlInL * kcalPerLiterO2
+
+	return caloriesPerMinute * duration.Minutes(), nil
+}
+
+// maxHeartRate оценивает максимальный пульс по возрасту и полу:
+// формула Танаки для мужчин и при неизвестном поле, формула Гулати для женщин.
+func maxHeartRate(age int, sex string) (float64, error) {
+	kind, err := ParseSex(sex)
+	if err != nil {
+		return 0, err
+	}
+
+	if kind == SexFemale {
+		return 206 - 0.88*float64(age), nil
+	}
+
+	return 208 - 0.7*float64(age), nil
+}
+
+// PercentOfMaxHR возвращает средний пульс тренировки в процентах от максимального,
+// рассчитанного по возрасту и полу.
+func PercentOfMaxHR(avgHR, age int, sex string) (float64, error) {
+	// Проверка входных параметров
+	if avgHR <= 0 {
+		return 0, fmt.Errorf("средний пульс должен быть больше 0")
+	}
+	if age <= 0 || age > 120 {
+		return 0, fmt.Errorf("возраст должен быть в диапазоне от 1 до 120 лет")
+	}
+
+	maxHR, err := maxHeartRate(age, sex)
+	if err != nil {
+		return 0, err
+	}
+
+	return float64(avgHR) / maxHR * 100, nil
+}
+
+// CaloriesPerBeat возвращает количество калорий, приходящееся на один удар сердца
+// за тренировку со средним пульсом avgHR уд/мин и длительностью duration.
+func CaloriesPerBeat(calories float64, avgHR int, duration time.Duration) (float64, error) {
+	// Проверка входных параметров
+	if calories < 0 {
+		return 0, fmt.Errorf("количество калорий не может быть отрицательным")
+	}
+	if avgHR <= 0 {
+		return 0, fmt.Errorf("средний пульс должен быть больше 0")
+	}
+	if duration <= 0 {
+		return 0, ErrNonPositiveDuration
+	}
+
+	// Общее количество ударов сердца за тренировку
+	beats := float64(avgHR) * duration.Minutes()
+
+	return calories / beats, nil
+}
+
+// RecoveryScore возвращает восстановление пульса за первую минуту после нагрузки —
+// на сколько ударов в минуту пульс снизился от hrEnd до hrAfter1Min.
+func RecoveryScore(hrEnd, hrAfter1Min int) (int, error) {
+	// Проверка входных параметров
+	if hrEnd <= 0 || hrAfter1Min <= 0 {
+		return 0, fmt.Errorf("пульс должен быть больше 0")
+	}
+	if hrAfter1Min > hrEnd {
+		return 0, fmt.Errorf("пульс через минуту не может быть выше пульса в конце нагрузки")
+	}
+
+	return hrEnd - hrAfter1Min, nil
+}
+
+// Допустимый диапазон среднего пульса для расчета калорий по пульсу.
+const (
+	minAvgHR = 40
+	maxAvgHR = 220
+)
+
+// keytelCoefficients — коэффициенты уравнения Кейтела для расчета расхода энергии по пульсу
+// в кДж/мин: intercept + hr*пульс + weight*вес + age*возраст.
+type keytelCoefficients struct {
+	intercept, hr, weight, age float64
+}
+
+var (
+	keytelMale   = keytelCoefficients{intercept: -55.0969, hr: 0.6309, weight: 0.1988, age: 0.2017}
+	keytelFemale = keytelCoefficients{intercept: -20.4022, hr: 0.4472, weight: -0.1263, age: 0.074}
+)
+
+func (k keytelCoefficients) kJPerMinute(avgHR int, weight float64, age int) float64 {
+	return k.intercept + k.hr*float64(avgHR) + k.weight*weight + k.age*float64(age)
+}
+
+// CaloriesFromHeartRate рассчитывает калории по среднему пульсу по уравнениям Кейтела
+// с отдельными коэффициентами для мужчин и женщин. Если пол не указан,
+// берётся среднее значение двух уравнений. Средний пульс должен быть от 40 до 220 уд/мин.
+func CaloriesFromHeartRate(avgHR int, weight float64, age int, sex Sex, duration time.Duration) (float64, error) {
+	// Проверка входных параметров
+	if avgHR < minAvgHR || avgHR > maxAvgHR {
+		return 0, fmt.Errorf("средний пульс должен быть в диапазоне от %d до %d уд/мин", minAvgHR, maxAvgHR)
+	}
+	if weight <= 0 {
+		return 0, fmt.Errorf("вес должен быть больше 0")
+	}
+	if age <= 0 || age > 120 {
+		return 0, fmt.Errorf("возраст должен быть в диапазоне от 1 до 120 лет")
+	}
+	if duration <= 0 {
+		return 0, ErrNonPositiveDuration
+	}
+
+	// Расход энергии в кДж в минуту зависит от пола
+	var perMinute float64
+	switch sex {
+	case SexMale:
+		perMinute = keytelMale.kJPerMinute(avgHR, weight, age)
+	case SexFemale:
+		perMinute = keytelFemale.kJPerMinute(avgHR, weight, age)
+	default:
+		perMinute = (keytelMale.kJPerMinute(avgHR, weight, age) + keytelFemale.kJPerMinute(avgHR, weight, age)) / 2
+	}
+
+	// При низком пульсе уравнение может дать отрицательный расход
+	if perMinute < 0 {
+		return 0, nil
+	}
+
+	// Переводим килоджоули в килокалории
+	return perMinute * duration.Minutes() * mInKm / joulesInKcal, nil
+}
+
+// TrainingInfoHR работает как TrainingInfo, но при avgHR > 0 рассчитывает калории
+// по пульсу с помощью CaloriesFromHeartRate. При avgHR <= 0 используется расчет по шагам.
+func TrainingInfoHR(data string, weight, height float64, avgHR, age int, sex Sex) (string, error) {
+	result, err := trainingInfo(data, weight, height)
+	if err != nil {
+		return "", err
+	}
+
+	if err := applyHeartRate(&result, avgHR, weight, age, sex); err != nil {
+		return "", err
+	}
+
+	return result.String(), nil
+}
+
+// TrainingInfoWithHR работает как TrainingInfo, но принимает запись с необязательным
+// средним пульсом: "шаги,активность,длительность[,пульс]". Если пульс указан, калории
+// рассчитываются по нему с помощью CaloriesFromHeartRate, иначе — по шагам.
+func TrainingInfoWithHR(data string, weight, height float64, age int, sex Sex) (string, error) {
+	result, err := NewTrainingWithHR(data, weight, height, 0, age, sex)
+	if err != nil {
+		return "", err
+	}
+
+	return result.String(), nil
+}
+
+// NewTrainingWithHR разбирает запись "шаги,активность,длительность[,пульс]" так же,
+// как TrainingInfoWithHR, и возвращает показатели тренировки. Если stepLength больше 0,
+// дистанция и скорость рассчитываются по этой длине шага, как в NewTrainingWithStepLength.
+func NewTrainingWithHR(data string, weight, height, stepLength float64, age int, sex Sex) (Training, error) {
+	// Получаем данные о тренировке и пульс, если он указан
+	steps, activity, duration, avgHR, err := parseTrainingHR(data)
+	if err != nil {
+		logger().Error("ошибка разбора данных", "record", data, "error", err)
+		return Training{}, err
+	}
+
+	result, err := trainingResult(activity, steps, duration, weight, height, stepLength)
+	if err != nil {
+		return Training{}, err
+	}
+
+	if err := applyHeartRate(&result, avgHR, weight, age, sex); err != nil {
+		return Training{}, err
+	}
+
+	return result, nil
+}
+
+// applyHeartRate заменяет калории тренировки расчетом по пульсу, если avgHR > 0.
+func applyHeartRate(result *TrainingResult, avgHR int, weight float64, age int, sex Sex) error {
+	// Пульс — более точный источник калорий, чем шаги
+	if avgHR <= 0 {
+		return nil
+	}
+
+	calories, err := CaloriesFromHeartRate(avgHR, weight, age, sex, result.Duration)
+	if err != nil {
+		return err
+	}
+
+	result.Calories = calories
+	return nil
+}
+
+// parseTrainingHR разбирает запись "шаги,активность,длительность[,пульс]".
+// Если пульс не указан, возвращается avgHR = 0.
+func parseTrainingHR(data string) (int, string, time.Duration, int, error) {
+	parts := strings.Split(data, ",")
+
+	switch len(parts) {
+	case 3:
+		steps, activity, duration, err := parseTraining(data)
+		return steps, activity, duration, 0, err
+	case 4:
+	default:
+		return 0, "", 0, 0, newParseError(FieldRecord, data, fmt.Errorf("%w, ожидается 'шаги,активность,длительность[,пульс]'", ErrInvalidFormat))
+	}
+
+	steps, activity, duration, err := parseTraining(strings.Join(parts[:3], ","))
+	if err != nil {
+		return 0, "", 0, 0, err
+	}
+
+	// Парсим средний пульс
+	avgHR, err := strconv.Atoi(strings.TrimSpace(parts[3]))
+	if err != nil {
+		return 0, "", 0, 0, newParseError(FieldHeartRate, parts[3], fmt.Errorf("неверный формат пульса: %w", err))
+	}
+	if avgHR < minAvgHR || avgHR > maxAvgHR {
+		return 0, "", 0, 0, newParseError(FieldHeartRate, parts[3], fmt.Errorf("средний пульс должен быть в диапазоне от %d до %d уд/мин", minAvgHR, maxAvgHR))
+	}
+
+	return steps, activity, duration, avgHR, nil
+}
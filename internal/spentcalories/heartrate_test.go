@@ -0,0 +1,434 @@
+package spentcalories
+
+import (
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestHRReserveCalories() {
+	tests := []struct {
+		name      string
+		avgHR     int
+		restingHR int
+		maxHR     int
+		weight    float64
+		duration  time.Duration
+		wantCal   float64
+		wantErr   bool
+	}{
+		{
+			name:      "середина резерва",
+			avgHR:     120,
+			restingHR: 60,
+			maxHR:     180,
+			weight:    75.0,
+			duration:  1 * time.Hour,
+			wantCal:   433.125,
+			wantErr:   false,
+		},
+		{
+			name:      "пульс покоя",
+			avgHR:     60,
+			restingHR: 60,
+			maxHR:     180,
+			weight:    75.0,
+			duration:  1 * time.Hour,
+			wantCal:   78.75,
+			wantErr:   false,
+		},
+		{
+			name:      "средний пульс выше максимального",
+			avgHR:     190,
+			restingHR: 60,
+			maxHR:     180,
+			weight:    75.0,
+			duration:  1 * time.Hour,
+			wantErr:   true,
+		},
+		{
+			name:      "средний пульс ниже пульса покоя",
+			avgHR:     50,
+			restingHR: 60,
+			maxHR:     180,
+			weight:    75.0,
+			duration:  1 * time.Hour,
+			wantErr:   true,
+		},
+		{
+			name:      "пульс покоя выше максимального",
+			avgHR:     120,
+			restingHR: 190,
+			maxHR:     180,
+			weight:    75.0,
+			duration:  1 * time.Hour,
+			wantErr:   true,
+		},
+		{
+			name:      "нулевой вес",
+			avgHR:     120,
+			restingHR: 60,
+			maxHR:     180,
+			weight:    0,
+			duration:  1 * time.Hour,
+			wantErr:   true,
+		},
+		{
+			name:      "нулевая продолжительность",
+			avgHR:     120,
+			restingHR: 60,
+			maxHR:     180,
+			weight:    75.0,
+			duration:  0,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := HRReserveCalories(tt.avgHR, tt.restingHR, tt.maxHR, tt.weight, tt.duration)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Equal(suite.T(), 0.0, got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.wantCal, got, 0.001)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestPercentOfMaxHR() {
+	tests := []struct {
+		name    string
+		avgHR   int
+		age     int
+		sex     string
+		want    float64
+		wantErr bool
+	}{
+		{
+			name:    "мужчина 40 лет",
+			avgHR:   144,
+			age:     40,
+			sex:     "male",
+			want:    80,
+			wantErr: false,
+		},
+		{
+			name:    "женщина 50 лет",
+			avgHR:   121,
+			age:     50,
+			sex:     "ж",
+			want:    74.69,
+			wantErr: false,
+		},
+		{
+			name:    "пол не указан",
+			avgHR:   144,
+			age:     40,
+			sex:     "",
+			want:    80,
+			wantErr: false,
+		},
+		{
+			name:    "неизвестный пол",
+			avgHR:   144,
+			age:     40,
+			sex:     "x",
+			wantErr: true,
+		},
+		{
+			name:    "нулевой пульс",
+			avgHR:   0,
+			age:     40,
+			sex:     "male",
+			wantErr: true,
+		},
+		{
+			name:    "некорректный возраст",
+			avgHR:   144,
+			age:     0,
+			sex:     "male",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := PercentOfMaxHR(tt.avgHR, tt.age, tt.sex)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Equal(suite.T(), 0.0, got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 0.01)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestCaloriesPerBeat() {
+	tests := []struct {
+		name     string
+		calories float64
+		avgHR    int
+		duration time.Duration
+		want     float64
+		wantErr  bool
+	}{
+		{
+			name:     "часовая тренировка при пульсе 150",
+			calories: 600,
+			avgHR:    150,
+			duration: time.Hour,
+			want:     600.0 / 9000,
+			wantErr:  false,
+		},
+		{
+			name:     "полчаса при пульсе 120",
+			calories: 180,
+			avgHR:    120,
+			duration: 30 * time.Minute,
+			want:     0.05,
+			wantErr:  false,
+		},
+		{
+			name:     "нулевой пульс",
+			calories: 600,
+			avgHR:    0,
+			duration: time.Hour,
+			wantErr:  true,
+		},
+		{
+			name:     "нулевая длительность",
+			calories: 600,
+			avgHR:    150,
+			duration: 0,
+			wantErr:  true,
+		},
+		{
+			name:     "отрицательные калории",
+			calories: -1,
+			avgHR:    150,
+			duration: time.Hour,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := CaloriesPerBeat(tt.calories, tt.avgHR, tt.duration)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestRecoveryScore() {
+	tests := []struct {
+		name    string
+		hrEnd   int
+		hrAfter int
+		want    int
+		wantErr bool
+	}{
+		{
+			name:    "хорошее восстановление",
+			hrEnd:   170,
+			hrAfter: 140,
+			want:    30,
+			wantErr: false,
+		},
+		{
+			name:    "пульс не изменился",
+			hrEnd:   120,
+			hrAfter: 120,
+			want:    0,
+			wantErr: false,
+		},
+		{
+			name:    "пульс вырос",
+			hrEnd:   120,
+			hrAfter: 130,
+			wantErr: true,
+		},
+		{
+			name:    "нулевой пульс",
+			hrEnd:   0,
+			hrAfter: 0,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := RecoveryScore(tt.hrEnd, tt.hrAfter)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestCaloriesFromHeartRate() {
+	tests := []struct {
+		name    string
+		avgHR   int
+		age     int
+		sex     Sex
+		want    float64
+		wantErr bool
+	}{
+		{
+			name:    "мужчина",
+			avgHR:   150,
+			age:     30,
+			sex:     SexMale,
+			want:    867.58,
+			wantErr: false,
+		},
+		{
+			name:    "женщина",
+			avgHR:   150,
+			age:     30,
+			sex:     SexFemale,
+			want:    565.37,
+			wantErr: false,
+		},
+		{
+			name:    "пол не указан",
+			avgHR:   150,
+			age:     30,
+			sex:     SexUnknown,
+			want:    716.48,
+			wantErr: false,
+		},
+		{
+			name:    "слишком низкий пульс",
+			avgHR:   30,
+			age:     30,
+			sex:     SexMale,
+			wantErr: true,
+		},
+		{
+			name:    "слишком высокий пульс",
+			avgHR:   230,
+			age:     30,
+			sex:     SexMale,
+			wantErr: true,
+		},
+		{
+			name:    "возраст не указан",
+			avgHR:   150,
+			age:     0,
+			sex:     SexMale,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := CaloriesFromHeartRate(tt.avgHR, 75.0, tt.age, tt.sex, time.Hour)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 0.01)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoHR() {
+	got, err := TrainingInfoHR("6000,Бег,1h00m", 75.0, 1.75, 150, 30, SexMale)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Сожгли калорий: 867.58")
+
+	// Без пульса используется расчет по шагам
+	got, err = TrainingInfoHR("6000,Бег,1h00m", 75.0, 1.75, 0, 30, SexMale)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Сожгли калорий: 354.38")
+
+	_, err = TrainingInfoHR("6000,Бег,1h00m", 75.0, 1.75, 250, 30, SexMale)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoWithHR() {
+	tests := []struct {
+		name      string
+		input     string
+		want      string
+		wantField string
+	}{
+		{
+			name:  "пульс указан",
+			input: "6000,Бег,1h00m,150",
+			want:  "Сожгли калорий: 867.58",
+		},
+		{
+			name:  "пульс с пробелами",
+			input: "6000, Бег, 1h00m, 150 ",
+			want:  "Сожгли калорий: 867.58",
+		},
+		{
+			name:  "без пульса",
+			input: "6000,Бег,1h00m",
+			want:  "Сожгли калорий: 354.38",
+		},
+		{
+			name:      "некорректный пульс",
+			input:     "6000,Бег,1h00m,abc",
+			wantField: FieldHeartRate,
+		},
+		{
+			name:      "пульс вне диапазона",
+			input:     "6000,Бег,1h00m,250",
+			wantField: FieldHeartRate,
+		},
+		{
+			name:      "некорректные шаги при указанном пульсе",
+			input:     "0,Бег,1h00m,150",
+			wantField: FieldSteps,
+		},
+		{
+			name:      "лишние поля",
+			input:     "6000,Бег,1h00m,150,30",
+			wantField: FieldRecord,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := TrainingInfoWithHR(tt.input, 75.0, 1.75, 30, SexMale)
+
+			if tt.wantField != "" {
+				var parseErr *ParseError
+				assert.True(suite.T(), errors.As(err, &parseErr))
+				assert.Equal(suite.T(), tt.wantField, parseErr.Field)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Contains(suite.T(), got, tt.want)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package spentcalories
+
+import "fmt"
+
+// Допустимый диапазон роста человека в метрах.
+const (
+	minHeight = 0.5
+	maxHeight = 2.5
+
+	cmInM = 100 // количество сантиметров в метре.
+)
+
+// ValidateHeight проверяет рост в метрах по тем же правилам, что и расчеты пакета:
+// рост должен быть в диапазоне 0,5–2,5 м, рост в сантиметрах отклоняется с подсказкой.
+func ValidateHeight(height float64) error {
+	return validateHeight(height)
+}
+
+// validateHeight проверяет, что рост задан в метрах и находится в диапазоне 0,5–2,5 м.
+// Значения, похожие на рост в сантиметрах, не пересчитываются автоматически,
+// а возвращают ошибку с подсказкой, чтобы не испортить данные незаметно.
+func validateHeight(height float64) error {
+	if height <= 0 {
+		return fmt.Errorf("рост должен быть больше 0")
+	}
+
+	// Рост в сантиметрах попадает в допустимый диапазон после деления на 100
+	if height > maxHeight && height/cmInM >= minHeight && height/cmInM <= maxHeight {
+		return fmt.Errorf("рост %g похож на значение в сантиметрах, ожидается рост в метрах: %g", height, height/cmInM)
+	}
+
+	if height < minHeight || height > maxHeight {
+		return fmt.Errorf("рост %g м вне допустимого диапазона %g–%g м", height, minHeight, maxHeight)
+	}
+
+	return nil
+}
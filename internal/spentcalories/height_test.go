@@ -0,0 +1,72 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestValidateHeight() {
+	tests := []struct {
+		name    string
+		height  float64
+		wantErr string
+	}{
+		{
+			name:   "рост в метрах",
+			height: 1.75,
+		},
+		{
+			name:   "нижняя граница",
+			height: 0.5,
+		},
+		{
+			name:   "верхняя граница",
+			height: 2.5,
+		},
+		{
+			name:    "рост в сантиметрах",
+			height:  170,
+			wantErr: "похож на значение в сантиметрах, ожидается рост в метрах: 1.7",
+		},
+		{
+			name:    "слишком маленький рост",
+			height:  0.3,
+			wantErr: "вне допустимого диапазона",
+		},
+		{
+			name:    "слишком большой рост",
+			height:  17,
+			wantErr: "вне допустимого диапазона",
+		},
+		{
+			name:    "нулевой рост",
+			height:  0,
+			wantErr: "рост должен быть больше 0",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			err := ValidateHeight(tt.height)
+
+			if tt.wantErr != "" {
+				assert.ErrorContains(suite.T(), err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestHeightInCentimetersRejected() {
+	_, err := RunningSpentCalories(6000, 75.0, 175, time.Hour)
+	assert.ErrorContains(suite.T(), err, "сантиметрах")
+
+	_, err = WalkingSpentCalories(6000, 75.0, 175, time.Hour)
+	assert.ErrorContains(suite.T(), err, "сантиметрах")
+
+	_, err = TrainingInfo("6000,Бег,1h00m", 75.0, 175)
+	assert.ErrorContains(suite.T(), err, "сантиметрах")
+}
@@ -0,0 +1,61 @@
+package spentcalories
+
+// Locale — язык текстовых отчётов.
+type Locale string
+
+// Поддерживаемые языки отчётов.
+const (
+	LocaleRu Locale = "ru" // русский, используется по умолчанию.
+	LocaleEn Locale = "en" // английский.
+)
+
+// LocaleOrDefault возвращает язык отчётов из настроек; для пустого значения — LocaleRu.
+func (o FormatOptions) LocaleOrDefault() Locale {
+	if o.Locale == "" {
+		return LocaleRu
+	}
+	return o.Locale
+}
+
+// trainingMessages — шаблон отчёта о тренировке и названия встроенных активностей на одном языке.
+// В шаблон подставляются значения и обозначения единиц дистанции и скорости.
+type trainingMessages struct {
+	report        string
+	activityNames map[string]string // ключ — каноническое название; nil — название выводится как введено.
+}
+
+var trainingCatalog = map[Locale]trainingMessages{
+	LocaleRu: {
+		report: "Тип тренировки: %s\nДлительность: %s ч.\nДистанция: %s %s.\nСкорость: %s %s\nСожгли калорий: %s\n",
+	},
+	LocaleEn: {
+		report: "Activity: %s\nDuration: %s h\nDistance: %s %s\nSpeed: %s %s\nCalories burned: %s\n",
+		activityNames: map[string]string{
+			activityRunning:  "Running",
+			activityWalking:  "Walking",
+			activityCycling:  "Cycling",
+			activitySwimming: "Swimming",
+		},
+	},
+}
+
+// activityName переводит название встроенной активности; пользовательские активности
+// выводятся так, как они указаны в записи.
+func (m trainingMessages) activityName(activity string) string {
+	if m.activityNames == nil {
+		return activity
+	}
+
+	kind, ok := canonicalActivity(activity)
+	if !ok && isSwimming(activity) {
+		kind, ok = activitySwimming, true
+	}
+	if !ok {
+		return activity
+	}
+
+	if name, ok := m.activityNames[kind]; ok {
+		return name
+	}
+	return activity
+}
@@ -0,0 +1,78 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Yandex-Practicum/tracker/internal/units"
+)
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoLocaleAndUnits() {
+	en := DefaultFormatOptions()
+	en.Locale = LocaleEn
+
+	tests := []struct {
+		name  string
+		input string
+		opts  FormatOptions
+		want  string
+	}{
+		{
+			name:  "бег по-английски",
+			input: "6000,Бег,1h00m",
+			opts:  en,
+			want:  "Activity: Running\nDuration: 1.00 h\nDistance: 4.72 km\nSpeed: 4.72 km/h\nCalories burned: 354.38\n",
+		},
+		{
+			name:  "синоним ходьбы по-английски",
+			input: "6000,walk,1h00m",
+			opts:  en,
+			want:  "Activity: Walking\nDuration: 1.00 h\nDistance: 4.72 km\nSpeed: 4.72 km/h\nCalories burned: 177.19\n",
+		},
+		{
+			name:  "плавание по-английски",
+			input: "40,Плавание,1h00m,25",
+			opts:  en,
+			want:  "Activity: Swimming\nDuration: 1.00 h\nDistance: 1.00 km\nSpeed: 1.00 km/h\nCalories burned: 315.00\n",
+		},
+		{
+			name:  "мили по-английски",
+			input: "6000,Бег,1h00m",
+			opts:  FormatOptions{DistancePrecision: 2, SpeedPrecision: 2, CaloriesPrecision: 2, Locale: LocaleEn, Units: units.Imperial},
+			want:  "Activity: Running\nDuration: 1.00 h\nDistance: 2.94 mi\nSpeed: 2.94 mph\nCalories burned: 354.38\n",
+		},
+		{
+			name:  "мили по-русски",
+			input: "6000,Бег,1h00m",
+			opts:  FormatOptions{DistancePrecision: 2, SpeedPrecision: 2, CaloriesPrecision: 2, Units: units.Imperial},
+			want:  "Тип тренировки: Бег\nДлительность: 1.00 ч.\nДистанция: 2.94 миль.\nСкорость: 2.94 миль/ч\nСожгли калорий: 354.38\n",
+		},
+		{
+			name:  "язык не указан",
+			input: "6000,Бег,1h00m",
+			opts:  FormatOptions{DistancePrecision: 2, SpeedPrecision: 2, CaloriesPrecision: 2},
+			want:  "Тип тренировки: Бег\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\nСкорость: 4.72 км/ч\nСожгли калорий: 354.38\n",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := TrainingInfoFormat(tt.input, 75.0, 1.75, tt.opts)
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+
+	_, err := TrainingInfoFormat("6000,Бег,1h00m", 75.0, 1.75, FormatOptions{Locale: "de"})
+	assert.Error(suite.T(), err)
+
+	_, err = TrainingInfoFormat("6000,Бег,1h00m", 75.0, 1.75, FormatOptions{Units: "nautical"})
+	assert.Error(suite.T(), err)
+}
+
+func (suite *SpentCaloriesTestSuite) TestActivityNameRegistered() {
+	messages := trainingCatalog[LocaleEn]
+
+	// Пользовательские активности не переводятся
+	assert.Equal(suite.T(), "Йога", messages.activityName("Йога"))
+	assert.Equal(suite.T(), "Бег", trainingCatalog[LocaleRu].activityName("Бег"))
+}
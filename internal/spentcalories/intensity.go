@@ -0,0 +1,80 @@
+package spentcalories
+
+import "time"
+
+// Категории интенсивности тренировки.
+const (
+	IntensityLight    = "light"
+	IntensityModerate = "moderate"
+	IntensityVigorous = "vigorous"
+)
+
+// intensityBand задаёт границы скорости в км/ч для категорий интенсивности.
+type intensityBand struct {
+	moderate float64 // скорость, начиная с которой нагрузка умеренная.
+	vigorous float64 // скорость, начиная с которой нагрузка высокая.
+}
+
+// Границы интенсивности для каждого вида активности.
+var intensityBands = map[string]intensityBand{
+	activityWalking: {moderate: 4, vigorous: 6},
+	activityRunning: {moderate: 8, vigorous: 11},
+	activityCycling: {moderate: 16, vigorous: 22},
+}
+
+// IntensityLabel возвращает категорию интенсивности для средней скорости в км/ч
+// и вида активности. Для неизвестной активности возвращается пустая строка.
+func IntensityLabel(speedKmH float64, activity string) string {
+	kind, ok := canonicalActivity(activity)
+	if !ok {
+		return ""
+	}
+
+	band := intensityBands[kind]
+
+	// Сравниваем скорость с границами категорий
+	switch {
+	case speedKmH >= band.vigorous:
+		return IntensityVigorous
+	case speedKmH >= band.moderate:
+		return IntensityModerate
+	default:
+		return IntensityLight
+	}
+}
+
+// Категории сессии по длительности.
+const (
+	SessionExercise   = "exercise"
+	SessionIncidental = "incidental"
+)
+
+// Минимальная длительность, начиная с которой сессия считается тренировкой.
+const defaultMinExerciseDuration = 10 * time.Minute
+
+var minExerciseDurations = map[string]time.Duration{
+	activityWalking: 10 * time.Minute,
+	activityRunning: 5 * time.Minute,
+}
+
+// MinExerciseDuration возвращает минимальную длительность, начиная с которой сессия
+// данного вида активности считается тренировкой, а не случайной активностью.
+func MinExerciseDuration(activity string) time.Duration {
+	kind, _ := canonicalActivity(activity)
+
+	if d, ok := minExerciseDurations[kind]; ok {
+		return d
+	}
+
+	return defaultMinExerciseDuration
+}
+
+// ClassifySession возвращает SessionExercise, если сессия не короче MinExerciseDuration,
+// и SessionIncidental в противном случае.
+func ClassifySession(activity string, duration time.Duration) string {
+	if duration >= MinExerciseDuration(activity) {
+		return SessionExercise
+	}
+
+	return SessionIncidental
+}
@@ -0,0 +1,118 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestIntensityLabel() {
+	tests := []struct {
+		name     string
+		speed    float64
+		activity string
+		want     string
+	}{
+		{
+			name:     "ходьба - лёгкая",
+			speed:    3.0,
+			activity: "Ходьба",
+			want:     IntensityLight,
+		},
+		{
+			name:     "ходьба - умеренная",
+			speed:    4.72,
+			activity: "walking",
+			want:     IntensityModerate,
+		},
+		{
+			name:     "ходьба - высокая",
+			speed:    6.5,
+			activity: "walk",
+			want:     IntensityVigorous,
+		},
+		{
+			name:     "бег - лёгкая",
+			speed:    7.0,
+			activity: "Бег",
+			want:     IntensityLight,
+		},
+		{
+			name:     "бег - умеренная",
+			speed:    9.5,
+			activity: "running",
+			want:     IntensityModerate,
+		},
+		{
+			name:     "бег - высокая",
+			speed:    15.75,
+			activity: "run",
+			want:     IntensityVigorous,
+		},
+		{
+			name:     "бег - граница умеренной нагрузки",
+			speed:    8.0,
+			activity: "Бег",
+			want:     IntensityModerate,
+		},
+		{
+			name:     "неизвестная активность",
+			speed:    10.0,
+			activity: "Плавание",
+			want:     "",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got := IntensityLabel(tt.speed, tt.activity)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestClassifySession() {
+	tests := []struct {
+		name        string
+		activity    string
+		duration    time.Duration
+		wantMin     time.Duration
+		wantSession string
+	}{
+		{
+			name:        "короткая прогулка",
+			activity:    "Ходьба",
+			duration:    2 * time.Minute,
+			wantMin:     10 * time.Minute,
+			wantSession: SessionIncidental,
+		},
+		{
+			name:        "прогулка на пороге",
+			activity:    "walk",
+			duration:    10 * time.Minute,
+			wantMin:     10 * time.Minute,
+			wantSession: SessionExercise,
+		},
+		{
+			name:        "короткая пробежка",
+			activity:    "Бег",
+			duration:    6 * time.Minute,
+			wantMin:     5 * time.Minute,
+			wantSession: SessionExercise,
+		},
+		{
+			name:        "неизвестная активность",
+			activity:    "Плавание",
+			duration:    8 * time.Minute,
+			wantMin:     10 * time.Minute,
+			wantSession: SessionIncidental,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.wantMin, MinExerciseDuration(tt.activity))
+			assert.Equal(suite.T(), tt.wantSession, ClassifySession(tt.activity, tt.duration))
+		})
+	}
+}
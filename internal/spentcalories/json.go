@@ -0,0 +1,88 @@
+package spentcalories
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// trainingJSON — запись тренировки в формате JSON.
+type trainingJSON struct {
+	Steps           int     `json:"steps"`
+	Activity        string  `json:"activity"`
+	Duration        string  `json:"duration"`
+	DurationSeconds float64 `json:"duration_seconds"`
+}
+
+// ParseTrainingJSON разбирает тренировку в формате
+// {"steps":6000,"activity":"бег","duration":"1h30m"} или
+// {"steps":6000,"activity":"бег","duration_seconds":3600}
+// и проверяет её по тем же правилам, что и строковый формат.
+func ParseTrainingJSON(data []byte) (int, string, time.Duration, error) {
+	var record trainingJSON
+	if err := json.Unmarshal(data, &record); err != nil {
+		return 0, "", 0, newParseError(FieldRecord, string(data), fmt.Errorf("неверный формат JSON: %w", err))
+	}
+
+	// Проверяем количество шагов и вид активности
+	if err := checkSteps(record.Steps, strconv.Itoa(record.Steps)); err != nil {
+		return 0, "", 0, err
+	}
+
+	activity := strings.TrimSpace(record.Activity)
+	if err := checkActivity(activity, record.Activity); err != nil {
+		return 0, "", 0, err
+	}
+
+	// Разбираем длительность из строки или секунд и проверяем её
+	duration, err := jsonDuration(record.Duration, record.DurationSeconds)
+	if err != nil {
+		return 0, "", 0, err
+	}
+
+	return record.Steps, activity, duration, nil
+}
+
+// jsonDuration возвращает длительность JSON-записи: из строкового поля "duration"
+// в формате ParseDuration, например "1h30m", или из поля "duration_seconds".
+// Указывать можно только одно из полей.
+func jsonDuration(text string, seconds float64) (time.Duration, error) {
+	if text == "" {
+		return secondsToDuration(seconds)
+	}
+	if seconds != 0 {
+		return 0, newParseError(FieldDuration, text, fmt.Errorf("длительность должна быть указана только в одном из полей duration и duration_seconds"))
+	}
+
+	duration, err := ParseDuration(strings.TrimSpace(text))
+	if err != nil {
+		return 0, newParseError(FieldDuration, text, fmt.Errorf("неверный формат длительности: %w", err))
+	}
+	if err := checkDuration(duration, text); err != nil {
+		return 0, err
+	}
+
+	return duration, nil
+}
+
+// secondsToDuration переводит длительность в секундах в time.Duration
+// и проверяет, что она положительна.
+func secondsToDuration(seconds float64) (time.Duration, error) {
+	raw := strconv.FormatFloat(seconds, 'f', -1, 64)
+
+	// Проверяем, что длительность помещается в time.Duration
+	nanoseconds := seconds * float64(time.Second)
+	if nanoseconds >= math.MaxInt64 {
+		return 0, newParseError(FieldDuration, raw, fmt.Errorf("длительность слишком велика"))
+	}
+
+	duration := time.Duration(nanoseconds)
+	if err := checkDuration(duration, raw); err != nil {
+		return 0, err
+	}
+
+	return duration, nil
+}
@@ -0,0 +1,107 @@
+package spentcalories
+
+import (
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestParseTrainingJSON() {
+	tests := []struct {
+		name         string
+		input        string
+		wantSteps    int
+		wantActivity string
+		wantDuration time.Duration
+		wantField    string
+		wantErr      bool
+	}{
+		{
+			name:         "корректная запись",
+			input:        `{"steps":6000,"activity":"Бег","duration_seconds":3600}`,
+			wantSteps:    6000,
+			wantActivity: "Бег",
+			wantDuration: time.Hour,
+			wantErr:      false,
+		},
+		{
+			name:         "дробные секунды",
+			input:        `{"steps":678,"activity":" Ходьба ","duration_seconds":90.5}`,
+			wantSteps:    678,
+			wantActivity: "Ходьба",
+			wantDuration: 90*time.Second + 500*time.Millisecond,
+			wantErr:      false,
+		},
+		{
+			name:         "длительность строкой",
+			input:        `{"steps":10000,"activity":"бег","duration":"1h30m"}`,
+			wantSteps:    10000,
+			wantActivity: "бег",
+			wantDuration: 90 * time.Minute,
+			wantErr:      false,
+		},
+		{
+			name:      "некорректная длительность строкой",
+			input:     `{"steps":10000,"activity":"бег","duration":"скоро"}`,
+			wantField: FieldDuration,
+			wantErr:   true,
+		},
+		{
+			name:      "длительность в двух полях",
+			input:     `{"steps":10000,"activity":"бег","duration":"1h30m","duration_seconds":5400}`,
+			wantField: FieldDuration,
+			wantErr:   true,
+		},
+		{
+			name:      "некорректный JSON",
+			input:     `{"steps":`,
+			wantField: FieldRecord,
+			wantErr:   true,
+		},
+		{
+			name:      "нет шагов",
+			input:     `{"activity":"Бег","duration_seconds":3600}`,
+			wantField: FieldSteps,
+			wantErr:   true,
+		},
+		{
+			name:      "пустая активность",
+			input:     `{"steps":6000,"activity":"","duration_seconds":3600}`,
+			wantField: FieldActivity,
+			wantErr:   true,
+		},
+		{
+			name:      "отрицательная длительность",
+			input:     `{"steps":6000,"activity":"Бег","duration_seconds":-60}`,
+			wantField: FieldDuration,
+			wantErr:   true,
+		},
+		{
+			name:      "слишком большая длительность",
+			input:     `{"steps":6000,"activity":"Бег","duration_seconds":1e300}`,
+			wantField: FieldDuration,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			steps, activity, duration, err := ParseTrainingJSON([]byte(tt.input))
+
+			if tt.wantErr {
+				var parseErr *ParseError
+				assert.True(suite.T(), errors.As(err, &parseErr))
+				assert.Equal(suite.T(), tt.wantField, parseErr.Field)
+				assert.Equal(suite.T(), 0, steps)
+				assert.Equal(suite.T(), time.Duration(0), duration)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.wantSteps, steps)
+			assert.Equal(suite.T(), tt.wantActivity, activity)
+			assert.Equal(suite.T(), tt.wantDuration, duration)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package spentcalories
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestSetLogger() {
+	defer SetLogger(nil)
+
+	var buf bytes.Buffer
+	SetLogger(slog.New(slog.NewJSONHandler(&buf, nil)))
+
+	// Ошибка разбора записывается с исходной записью
+	_, err := TrainingInfo("6000,Бег", 75.0, 1.75)
+	assert.Error(suite.T(), err)
+
+	var entry map[string]any
+	assert.NoError(suite.T(), json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(suite.T(), "ERROR", entry["level"])
+	assert.Equal(suite.T(), "6000,Бег", entry["record"])
+	assert.Contains(suite.T(), entry["error"], "неверный формат данных")
+
+	// Ошибка расчета записывается с видом активности
+	buf.Reset()
+	_, err = TrainingInfo("6000,Плавание,1h00m", 75.0, 1.75)
+	assert.Error(suite.T(), err)
+
+	entry = nil
+	assert.NoError(suite.T(), json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(suite.T(), "Плавание", entry["activity"])
+	assert.Contains(suite.T(), entry["error"], "неизвестный тип тренировки")
+
+	// Успешный расчет ничего не пишет
+	buf.Reset()
+	_, err = TrainingInfo("6000,Бег,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), buf.String())
+}
@@ -0,0 +1,86 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+)
+
+// metBand — метаболический эквивалент (MET) для скорости ниже maxSpeed км/ч.
+type metBand struct {
+	maxSpeed float64
+	met      float64
+}
+
+// metTable — таблица MET для вида активности: диапазоны скорости по возрастанию
+// и значение для скорости выше последнего диапазона.
+type metTable struct {
+	bands []metBand
+	max   float64
+}
+
+// metTables — таблицы MET для встроенных видов активности по данным
+// Compendium of Physical Activities. Скорость указывается в км/ч.
+var metTables = map[string]metTable{
+	activityWalking: {
+		bands: []metBand{
+			{maxSpeed: 3.2, met: 2.0},
+			{maxSpeed: 4.0, met: 2.8},
+			{maxSpeed: 4.8, met: 3.0},
+			{maxSpeed: 5.6, met: 3.5},
+			{maxSpeed: 6.4, met: 4.3},
+			{maxSpeed: 7.2, met: 5.0},
+		},
+		max: 7.0,
+	},
+	activityRunning: {
+		bands: []metBand{
+			{maxSpeed: 6.4, met: 6.0},
+			{maxSpeed: 8.0, met: 8.3},
+			{maxSpeed: 9.7, met: 9.8},
+			{maxSpeed: 11.3, met: 11.0},
+			{maxSpeed: 12.9, met: 11.8},
+			{maxSpeed: 14.5, met: 12.8},
+			{maxSpeed: 16.1, met: 14.5},
+			{maxSpeed: 17.7, met: 16.0},
+		},
+		max: 19.0,
+	},
+	activityCycling: {
+		bands: cyclingMETBands,
+		max:   cyclingMETMax,
+	},
+}
+
+// metForSpeed возвращает MET первого диапазона, в который попадает скорость,
+// или max, если скорость выше всех диапазонов.
+func metForSpeed(bands []metBand, max, speedKmH float64) float64 {
+	for _, band := range bands {
+		if speedKmH < band.maxSpeed {
+			return band.met
+		}
+	}
+	return max
+}
+
+// CaloriesMET рассчитывает калории по таблице MET для вида активности:
+// калории = MET * вес * длительность в часах, где MET выбирается по средней скорости в км/ч.
+func CaloriesMET(activity string, weight float64, duration time.Duration, speed float64) (float64, error) {
+	kind, _ := canonicalActivity(activity)
+	table, ok := metTables[kind]
+	if !ok {
+		return 0, unknownActivityError(activity)
+	}
+
+	// Проверка входных параметров
+	if weight <= 0 {
+		return 0, fmt.Errorf("вес должен быть больше 0")
+	}
+	if duration <= 0 {
+		return 0, ErrNonPositiveDuration
+	}
+	if speed <= 0 {
+		return 0, fmt.Errorf("скорость должна быть больше 0")
+	}
+
+	return metForSpeed(table.bands, table.max, speed) * weight * duration.Hours(), nil
+}
@@ -0,0 +1,127 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestCaloriesMET() {
+	tests := []struct {
+		name     string
+		activity string
+		weight   float64
+		duration time.Duration
+		speed    float64
+		want     float64
+		wantErr  bool
+	}{
+		{
+			name:     "ходьба 5 км/ч",
+			activity: "Ходьба",
+			weight:   75.0,
+			duration: time.Hour,
+			speed:    5,
+			want:     3.5 * 75,
+			wantErr:  false,
+		},
+		{
+			name:     "быстрая ходьба выше таблицы",
+			activity: "walk",
+			weight:   80.0,
+			duration: 30 * time.Minute,
+			speed:    8,
+			want:     7.0 * 80 * 0.5,
+			wantErr:  false,
+		},
+		{
+			name:     "бег 10 км/ч",
+			activity: "Бег",
+			weight:   70.0,
+			duration: time.Hour,
+			speed:    10,
+			want:     11.0 * 70,
+			wantErr:  false,
+		},
+		{
+			name:     "велосипед 27 км/ч",
+			activity: "Велосипед",
+			weight:   75.0,
+			duration: 20 * time.Minute,
+			speed:    27,
+			want:     300,
+			wantErr:  false,
+		},
+		{
+			name:     "неизвестная активность",
+			activity: "Плавание",
+			weight:   75.0,
+			duration: time.Hour,
+			speed:    5,
+			wantErr:  true,
+		},
+		{
+			name:     "нулевой вес",
+			activity: "Бег",
+			weight:   0,
+			duration: time.Hour,
+			speed:    10,
+			wantErr:  true,
+		},
+		{
+			name:     "нулевая длительность",
+			activity: "Бег",
+			weight:   75.0,
+			duration: 0,
+			speed:    10,
+			wantErr:  true,
+		},
+		{
+			name:     "нулевая скорость",
+			activity: "Бег",
+			weight:   75.0,
+			duration: time.Hour,
+			speed:    0,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := CaloriesMET(tt.activity, tt.weight, tt.duration, tt.speed)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestSetCalorieMode() {
+	defer func() {
+		_ = SetCalorieMode(CalorieModeSpeed)
+	}()
+
+	// По умолчанию используется формула по скорости
+	got, err := RunningSpentCalories(6000, 75.0, 1.75, time.Hour)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 354.375, got, 1e-9)
+
+	assert.NoError(suite.T(), SetCalorieMode(CalorieModeMET))
+
+	// Скорость 4.725 км/ч: MET 6.0 для бега и 3.0 для ходьбы
+	got, err = RunningSpentCalories(6000, 75.0, 1.75, time.Hour)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 6.0*75, got, 1e-9)
+
+	got, err = WalkingSpentCalories(6000, 75.0, 1.75, time.Hour)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 3.0*75, got, 1e-9)
+
+	assert.Error(suite.T(), SetCalorieMode(CalorieMode(42)))
+	assert.Equal(suite.T(), CalorieModeMET, currentSettings().calorieMode)
+}
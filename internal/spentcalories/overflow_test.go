@@ -0,0 +1,37 @@
+package spentcalories
+
+import (
+	"math"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestHugeStepCounts() {
+	_, err := RunningSpentCalories(math.MaxInt, 75.0, 1.75, time.Hour)
+	assert.ErrorContains(suite.T(), err, "превышает допустимое значение")
+
+	_, err = WalkingSpentCalories(math.MaxInt, 75.0, 1.75, time.Hour)
+	assert.ErrorContains(suite.T(), err, "превышает допустимое значение")
+
+	got, err := TrainingInfo("9223372036854775807,Бег,1h00m", 75.0, 1.75)
+	assert.Error(suite.T(), err)
+	assert.NotContains(suite.T(), got, "NaN")
+	assert.NotContains(suite.T(), got, "Inf")
+
+	// Граничное значение ещё допустимо
+	_, err = RunningSpentCalories(maxSteps, 75.0, 1.75, time.Hour)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *SpentCaloriesTestSuite) TestRegisteredActivityNaN() {
+	suite.T().Cleanup(func() { unregisterActivity("сломанная") })
+
+	broken := func(int, float64, float64, time.Duration) (float64, error) {
+		return math.NaN(), nil
+	}
+	assert.NoError(suite.T(), RegisterActivity("Сломанная", broken))
+
+	_, err := TrainingInfo("6000,Сломанная,1h00m", 75.0, 1.75)
+	assert.ErrorContains(suite.T(), err, "некорректный результат")
+}
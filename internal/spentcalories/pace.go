@@ -0,0 +1,141 @@
+package spentcalories
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// TempoSegmentCalories рассчитывает калории для темпового отрезка бега
+// длиной distanceKm километров в темпе tempoPaceMinPerKm минут на километр.
+// Калории считаются по таблице MET для бега: темп определяет скорость, а от неё
+// зависит MET, поэтому один и тот же отрезок в разном темпе даёт разный расход.
+func TempoSegmentCalories(distanceKm, tempoPaceMinPerKm, weight float64) (float64, error) {
+	// Проверка входных параметров
+	if distanceKm <= 0 {
+		return 0, fmt.Errorf("дистанция должна быть больше 0")
+	}
+	if tempoPaceMinPerKm <= 0 {
+		return 0, fmt.Errorf("темп должен быть больше 0")
+	}
+	if weight <= 0 {
+		return 0, fmt.Errorf("вес должен быть больше 0")
+	}
+
+	// Переводим темп в скорость и вычисляем длительность отрезка
+	speed := minInH / tempoPaceMinPerKm
+	duration := time.Duration(distanceKm * tempoPaceMinPerKm * float64(time.Minute))
+
+	return CaloriesMET(activityRunning, weight, duration, speed)
+}
+
+// DistanceInTime возвращает дистанцию в километрах, которую можно преодолеть
+// за duration в темпе avgPaceMinPerKm минут на километр.
+func DistanceInTime(avgPaceMinPerKm float64, duration time.Duration) (float64, error) {
+	// Проверка входных параметров
+	if avgPaceMinPerKm <= 0 {
+		return 0, fmt.Errorf("темп должен быть больше 0")
+	}
+	if duration <= 0 {
+		return 0, ErrNonPositiveDuration
+	}
+
+	return duration.Minutes() / avgPaceMinPerKm, nil
+}
+
+// Segment — отрезок тренировки с количеством шагов и длительностью.
+type Segment struct {
+	Steps    int           // количество шагов на отрезке.
+	Duration time.Duration // продолжительность отрезка.
+}
+
+// PaceZoneDistribution распределяет время тренировки по зонам темпа.
+// Границы zoneBoundaries задаются в минутах на километр по возрастанию:
+// зона 0 — темп быстрее первой границы, зона i — темп от границы i-1 до границы i,
+// последняя зона — темп не быстрее последней границы. Отрезки без шагов или
+// длительности пропускаются. Если границы не упорядочены строго по возрастанию
+// или рост вне диапазона 0,5–2,5 м, возвращается ошибка.
+func PaceZoneDistribution(segments []Segment, height float64, zoneBoundaries []float64) (map[int]time.Duration, error) {
+	if err := validateHeight(height); err != nil {
+		return nil, err
+	}
+
+	// Проверяем, что границы зон упорядочены
+	for i := 1; i < len(zoneBoundaries); i++ {
+		if zoneBoundaries[i] <= zoneBoundaries[i-1] {
+			return nil, fmt.Errorf("границы зон темпа должны строго возрастать")
+		}
+	}
+
+	result := make(map[int]time.Duration)
+	for _, s := range segments {
+		if s.Steps <= 0 || s.Duration <= 0 {
+			continue
+		}
+
+		// Темп отрезка в минутах на километр
+		pace := s.Duration.Minutes() / distance(s.Steps, height)
+
+		// Номер зоны — количество границ, которые темп не быстрее
+		zone := sort.SearchFloat64s(zoneBoundaries, pace)
+		if zone < len(zoneBoundaries) && zoneBoundaries[zone] == pace {
+			zone++
+		}
+
+		result[zone] += s.Duration
+	}
+
+	return result, nil
+}
+
+// PaceConsistency возвращает коэффициент вариации темпа по отрезкам — отношение
+// стандартного отклонения темпа к среднему. Чем меньше значение, тем равномернее темп.
+// Отрезки без шагов или длительности пропускаются; для одного отрезка возвращается 0.
+// Рост вне диапазона 0,5–2,5 м возвращает ошибку.
+func PaceConsistency(segments []Segment, height float64) (float64, error) {
+	if err := validateHeight(height); err != nil {
+		return 0, err
+	}
+
+	paces := make([]float64, 0, len(segments))
+	for _, s := range segments {
+		if s.Steps <= 0 || s.Duration <= 0 {
+			continue
+		}
+		paces = append(paces, s.Duration.Minutes()/distance(s.Steps, height))
+	}
+
+	// Один отрезок считается идеально равномерным
+	if len(paces) < 2 {
+		return 0, nil
+	}
+
+	var mean float64
+	for _, p := range paces {
+		mean += p
+	}
+	mean /= float64(len(paces))
+
+	var variance float64
+	for _, p := range paces {
+		variance += (p - mean) * (p - mean)
+	}
+	variance /= float64(len(paces))
+
+	return math.Sqrt(variance) / mean, nil
+}
+
+// RequiredPace возвращает темп на километр, который нужно держать,
+// чтобы преодолеть remainingKm километров за timeLeft.
+func RequiredPace(remainingKm float64, timeLeft time.Duration) (time.Duration, error) {
+	// Проверка входных параметров
+	if remainingKm <= 0 {
+		return 0, fmt.Errorf("оставшаяся дистанция должна быть больше 0")
+	}
+	if timeLeft <= 0 {
+		return 0, fmt.Errorf("оставшееся время должно быть больше 0")
+	}
+
+	return time.Duration(float64(timeLeft) / remainingKm), nil
+}
@@ -0,0 +1,248 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestTempoSegmentCalories() {
+	tests := []struct {
+		name       string
+		distanceKm float64
+		pace       float64
+		weight     float64
+		wantCal    float64
+		wantErr    bool
+	}{
+		{
+			name:       "3 км в темпе 4:30",
+			distanceKm: 3,
+			pace:       4.5,
+			weight:     75.0,
+			wantCal:    12.8 * 75.0 * 0.225,
+			wantErr:    false,
+		},
+		{
+			name:       "3 км в темпе 7:00",
+			distanceKm: 3,
+			pace:       7,
+			weight:     75.0,
+			wantCal:    9.8 * 75.0 * 0.35,
+			wantErr:    false,
+		},
+		{
+			name:       "нулевая дистанция",
+			distanceKm: 0,
+			pace:       4.5,
+			weight:     75.0,
+			wantErr:    true,
+		},
+		{
+			name:       "отрицательный темп",
+			distanceKm: 3,
+			pace:       -4.5,
+			weight:     75.0,
+			wantErr:    true,
+		},
+		{
+			name:       "нулевой вес",
+			distanceKm: 3,
+			pace:       4.5,
+			weight:     0,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := TempoSegmentCalories(tt.distanceKm, tt.pace, tt.weight)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Equal(suite.T(), 0.0, got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.wantCal, got, 0.001)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTempoSegmentCaloriesDependsOnPace() {
+	fast, err := TempoSegmentCalories(3, 4.5, 70.0)
+	assert.NoError(suite.T(), err)
+	slow, err := TempoSegmentCalories(3, 7, 70.0)
+	assert.NoError(suite.T(), err)
+
+	// Темп влияет на расход калорий, а не сокращается в формуле
+	assert.NotEqual(suite.T(), fast, slow)
+	assert.NotEqual(suite.T(), 70.0*3, fast)
+}
+
+func (suite *SpentCaloriesTestSuite) TestDistanceInTime() {
+	tests := []struct {
+		name     string
+		pace     float64
+		duration time.Duration
+		wantKm   float64
+		wantErr  bool
+	}{
+		{
+			name:     "темп 6:00 за 30 минут",
+			pace:     6,
+			duration: 30 * time.Minute,
+			wantKm:   5,
+			wantErr:  false,
+		},
+		{
+			name:     "темп 4:30 за час",
+			pace:     4.5,
+			duration: time.Hour,
+			wantKm:   13.333,
+			wantErr:  false,
+		},
+		{
+			name:     "нулевой темп",
+			pace:     0,
+			duration: 30 * time.Minute,
+			wantErr:  true,
+		},
+		{
+			name:     "нулевая длительность",
+			pace:     6,
+			duration: 0,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := DistanceInTime(tt.pace, tt.duration)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Equal(suite.T(), 0.0, got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.wantKm, got, 0.001)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestPaceZoneDistribution() {
+	segments := []Segment{
+		{Steps: 3000, Duration: 15 * time.Minute}, // темп около 6:21 мин/км
+		{Steps: 3000, Duration: 30 * time.Minute}, // темп около 12:42 мин/км
+		{Steps: 0, Duration: 5 * time.Minute},
+	}
+
+	got, err := PaceZoneDistribution(segments, 1.75, []float64{5, 8})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), map[int]time.Duration{1: 15 * time.Minute, 2: 30 * time.Minute}, got)
+
+	got, err = PaceZoneDistribution(segments, 1.75, []float64{8, 5})
+	assert.Error(suite.T(), err)
+	assert.Nil(suite.T(), got)
+
+	// Рост в сантиметрах отклоняется
+	_, err = PaceZoneDistribution(segments, 175, []float64{5, 8})
+	assert.ErrorContains(suite.T(), err, "сантиметрах")
+}
+
+func (suite *SpentCaloriesTestSuite) TestPaceConsistency() {
+	tests := []struct {
+		name     string
+		segments []Segment
+		want     float64
+	}{
+		{
+			name: "переменный темп",
+			segments: []Segment{
+				{Steps: 3000, Duration: 15 * time.Minute},
+				{Steps: 3000, Duration: 30 * time.Minute},
+			},
+			want: 1.0 / 3,
+		},
+		{
+			name: "равномерный темп",
+			segments: []Segment{
+				{Steps: 3000, Duration: 15 * time.Minute},
+				{Steps: 6000, Duration: 30 * time.Minute},
+			},
+			want: 0,
+		},
+		{
+			name: "один отрезок",
+			segments: []Segment{
+				{Steps: 3000, Duration: 15 * time.Minute},
+			},
+			want: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := PaceConsistency(tt.segments, 1.75)
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+
+	_, err := PaceConsistency([]Segment{{Steps: 3000, Duration: 15 * time.Minute}}, 0)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *SpentCaloriesTestSuite) TestRequiredPace() {
+	tests := []struct {
+		name        string
+		remainingKm float64
+		timeLeft    time.Duration
+		want        time.Duration
+		wantErr     bool
+	}{
+		{
+			name:        "5 км за 30 минут",
+			remainingKm: 5,
+			timeLeft:    30 * time.Minute,
+			want:        6 * time.Minute,
+			wantErr:     false,
+		},
+		{
+			name:        "10 км за 55 минут",
+			remainingKm: 10,
+			timeLeft:    55 * time.Minute,
+			want:        5*time.Minute + 30*time.Second,
+			wantErr:     false,
+		},
+		{
+			name:        "нулевая дистанция",
+			remainingKm: 0,
+			timeLeft:    30 * time.Minute,
+			wantErr:     true,
+		},
+		{
+			name:        "время вышло",
+			remainingKm: 5,
+			timeLeft:    0,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := RequiredPace(tt.remainingKm, tt.timeLeft)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
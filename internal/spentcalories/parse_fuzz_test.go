@@ -0,0 +1,36 @@
+package spentcalories
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func FuzzParseTraining(f *testing.F) {
+	for _, input := range parseTrainingInputs {
+		f.Add(input)
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		steps, activity, duration, err := ParseTraining(input)
+		if err != nil {
+			if steps != 0 || activity != "" || duration != 0 {
+				t.Fatalf("при ошибке для %q возвращены ненулевые значения", input)
+			}
+			return
+		}
+
+		// Успешно разобранные данные всегда корректны
+		if steps <= 0 {
+			t.Fatalf("неположительное количество шагов %d для %q", steps, input)
+		}
+		if activity == "" {
+			t.Fatalf("пустая активность для %q", input)
+		}
+		if duration <= 0 {
+			t.Fatalf("неположительная длительность %v для %q", duration, input)
+		}
+		if utf8.ValidString(input) && !utf8.ValidString(activity) {
+			t.Fatalf("некорректная строка активности для %q", input)
+		}
+	})
+}
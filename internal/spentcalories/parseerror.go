@@ -0,0 +1,55 @@
+package spentcalories
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Названия полей записи для ParseError.
+const (
+	FieldRecord   = "record"   // запись целиком, например неверное количество полей.
+	FieldSteps    = "steps"    // количество шагов.
+	FieldActivity = "activity" // вид активности.
+	FieldDuration = "duration" // длительность.
+
+	FieldLaps       = "laps"        // количество кругов в записи плавания.
+	FieldPoolLength = "pool_length" // длина бассейна в записи плавания.
+	FieldHeartRate  = "heart_rate"  // средний пульс.
+)
+
+// ParseError описывает ошибку разбора записи: номер строки (если известен),
+// название поля и его исходное значение. Извлекается через errors.As.
+type ParseError struct {
+	Line  int    // номер строки, начиная с 1; 0 — номер строки неизвестен.
+	Field string // название поля, например FieldSteps.
+	Raw   string // исходное значение поля.
+	Err   error  // причина ошибки.
+}
+
+func newParseError(field, raw string, err error) *ParseError {
+	return &ParseError{Field: field, Raw: raw, Err: err}
+}
+
+func (e *ParseError) Error() string {
+	if e.Line > 0 {
+		return fmt.Sprintf("строка %d, поле %s (%q): %v", e.Line, e.Field, e.Raw, e.Err)
+	}
+
+	return fmt.Sprintf("поле %s (%q): %v", e.Field, e.Raw, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// withLine проставляет номер строки в ParseError или добавляет его к тексту другой ошибки.
+func withLine(err error, line int) error {
+	var parseErr *ParseError
+	if errors.As(err, &parseErr) {
+		withLine := *parseErr
+		withLine.Line = line
+		return &withLine
+	}
+
+	return fmt.Errorf("строка %d: %w", line, err)
+}
@@ -0,0 +1,74 @@
+package spentcalories
+
+import (
+	"errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestParseError() {
+	tests := []struct {
+		name      string
+		input     string
+		wantField string
+		wantRaw   string
+	}{
+		{
+			name:      "неверное количество полей",
+			input:     "678,Ходьба",
+			wantField: FieldRecord,
+			wantRaw:   "678,Ходьба",
+		},
+		{
+			name:      "некорректные шаги",
+			input:     "abc,Ходьба,1h30m",
+			wantField: FieldSteps,
+			wantRaw:   "abc",
+		},
+		{
+			name:      "нулевые шаги",
+			input:     "0,Ходьба,1h30m",
+			wantField: FieldSteps,
+			wantRaw:   "0",
+		},
+		{
+			name:      "пустая активность",
+			input:     "678, ,1h30m",
+			wantField: FieldActivity,
+			wantRaw:   " ",
+		},
+		{
+			name:      "некорректная длительность",
+			input:     "678,Ходьба,invalid",
+			wantField: FieldDuration,
+			wantRaw:   "invalid",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			_, _, _, err := parseTraining(tt.input)
+
+			var parseErr *ParseError
+			assert.True(suite.T(), errors.As(err, &parseErr))
+			assert.Equal(suite.T(), 0, parseErr.Line)
+			assert.Equal(suite.T(), tt.wantField, parseErr.Field)
+			assert.Equal(suite.T(), tt.wantRaw, parseErr.Raw)
+			assert.Error(suite.T(), parseErr.Unwrap())
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestParseErrorLine() {
+	_, err := MixedUnitsCalories([]string{
+		"6000,Ходьба,1h00m,75,1.75",
+		"6000,Ходьба,soon,75,1.75",
+	})
+
+	var parseErr *ParseError
+	assert.True(suite.T(), errors.As(err, &parseErr))
+	assert.Equal(suite.T(), 2, parseErr.Line)
+	assert.Equal(suite.T(), FieldDuration, parseErr.Field)
+	assert.Equal(suite.T(), "soon", parseErr.Raw)
+	assert.Contains(suite.T(), err.Error(), "строка 2, поле duration")
+}
@@ -0,0 +1,93 @@
+package spentcalories
+
+import "fmt"
+
+// polylinePrecision — множитель координат в формате Google Encoded Polyline.
+const polylinePrecision = 1e5
+
+// decodePolyline декодирует строку в формате Google Encoded Polyline в точки маршрута.
+func decodePolyline(encoded string) ([]TrackPoint, error) {
+	var (
+		points   []TrackPoint
+		lat, lon int
+	)
+
+	for i := 0; i < len(encoded); {
+		// Координаты хранятся как разность с предыдущей точкой
+		dLat, next, err := decodePolylineValue(encoded, i)
+		if err != nil {
+			return nil, err
+		}
+		dLon, next, err := decodePolylineValue(encoded, next)
+		if err != nil {
+			return nil, err
+		}
+		i = next
+
+		lat += dLat
+		lon += dLon
+		points = append(points, TrackPoint{
+			Lat: float64(lat) / polylinePrecision,
+			Lon: float64(lon) / polylinePrecision,
+		})
+	}
+
+	return points, nil
+}
+
+// decodePolylineValue декодирует одно число начиная с позиции i
+// и возвращает его вместе с позицией следующего числа.
+func decodePolylineValue(encoded string, i int) (int, int, error) {
+	var result, shift int
+
+	for {
+		if i >= len(encoded) {
+			return 0, 0, fmt.Errorf("неожиданный конец строки маршрута")
+		}
+
+		b := int(encoded[i]) - 63
+		i++
+		if b < 0 || b > 63 {
+			return 0, 0, fmt.Errorf("недопустимый символ в строке маршрута на позиции %d", i)
+		}
+		if shift > 30 {
+			return 0, 0, fmt.Errorf("слишком длинное значение в строке маршрута на позиции %d", i)
+		}
+
+		// Каждый символ несёт 5 бит значения, шестой бит означает продолжение
+		result |= (b & 0x1f) << shift
+		shift += 5
+
+		if b < 0x20 {
+			break
+		}
+	}
+
+	// Младший бит хранит знак
+	if result&1 != 0 {
+		return ^(result >> 1), i, nil
+	}
+
+	return result >> 1, i, nil
+}
+
+// PolylineCalories декодирует маршрут в формате Google Encoded Polyline
+// и рассчитывает его дистанцию в километрах и калории для вида активности.
+func PolylineCalories(encoded string, weight float64, activity string) (distanceKm, calories float64, err error) {
+	points, err := decodePolyline(encoded)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// Калории рассчитываем как для маршрута без данных о высоте
+	calories, err = RouteCalories(points, weight, activity)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for i := 1; i < len(points); i++ {
+		distanceKm += HaversineKm(points[i-1], points[i])
+	}
+
+	return distanceKm, calories, nil
+}
@@ -0,0 +1,43 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestDecodePolyline() {
+	// Пример из документации формата
+	got, err := decodePolyline("_p~iF~ps|U_ulLnnqC_mqNvxq`@")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []TrackPoint{
+		{Lat: 38.5, Lon: -120.2},
+		{Lat: 40.7, Lon: -120.95},
+		{Lat: 43.252, Lon: -126.453},
+	}, got)
+
+	_, err = decodePolyline("_p~iF~ps|U_")
+	assert.Error(suite.T(), err)
+
+	_, err = decodePolyline("_p~iF ~ps|U")
+	assert.Error(suite.T(), err)
+}
+
+func (suite *SpentCaloriesTestSuite) TestPolylineCalories() {
+	// Те же точки, что и в flatRoute: два отрезка примерно по 1 км
+	const encoded = "_eunI_qy`Fgw@?gw@?"
+
+	distanceKm, calories, err := PolylineCalories(encoded, 75.0, "Бег")
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 2.0013, distanceKm, 0.001)
+	assert.InDelta(suite.T(), 150.1, calories, 0.1)
+
+	distanceKm, calories, err = PolylineCalories(encoded, 75.0, "Ходьба")
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 2.0013, distanceKm, 0.001)
+	assert.InDelta(suite.T(), 75.05, calories, 0.1)
+
+	_, _, err = PolylineCalories(encoded, 75.0, "Плавание")
+	assert.Error(suite.T(), err)
+
+	_, _, err = PolylineCalories("", 75.0, "Бег")
+	assert.Error(suite.T(), err)
+}
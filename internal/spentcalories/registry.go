@@ -0,0 +1,93 @@
+package spentcalories
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// CalorieFunc рассчитывает калории для тренировки по количеству шагов, весу, росту и длительности.
+type CalorieFunc func(steps int, weight, height float64, duration time.Duration) (float64, error)
+
+// activities — реестр видов активности, через который TrainingInfo выбирает расчет калорий.
+// Встроенные активности хранятся под каноническими названиями, их синонимы задаются
+// в ActivityAliases. Пользовательские активности добавляются RegisterActivity;
+// ключ — название или синоним в нижнем регистре.
+var (
+	activitiesMu sync.RWMutex
+	activities   = map[string]CalorieCalculator{
+		activityRunning: RunningCalculator{},
+		activityWalking: WalkingCalculator{},
+		activityCycling: CyclingCalculator{},
+	}
+)
+
+// RegisterActivity регистрирует пользовательский вид активности с функцией расчета калорий
+// и необязательными синонимами. Повторная регистрация существующего названия возвращает ошибку.
+// Функцию безопасно вызывать из нескольких горутин.
+func RegisterActivity(name string, calcFn CalorieFunc, aliases ...string) error {
+	if calcFn == nil {
+		return fmt.Errorf("функция расчета калорий не задана")
+	}
+
+	names := make([]string, 0, len(aliases)+1)
+	for _, n := range append([]string{name}, aliases...) {
+		key := normalizeActivity(n)
+		if key == "" {
+			return fmt.Errorf("название активности не может быть пустым")
+		}
+		names = append(names, key)
+	}
+
+	activitiesMu.Lock()
+	defer activitiesMu.Unlock()
+
+	// Проверяем все названия до регистрации, чтобы не оставить реестр в промежуточном состоянии
+	seen := make(map[string]bool, len(names))
+	for _, key := range names {
+		if _, ok := canonicalActivity(key); ok {
+			return fmt.Errorf("активность %q уже встроена", key)
+		}
+		if _, ok := activities[key]; ok || seen[key] {
+			return fmt.Errorf("активность %q уже зарегистрирована", key)
+		}
+		seen[key] = true
+	}
+
+	for _, key := range names {
+		activities[key] = calcFn
+	}
+
+	return nil
+}
+
+// unregisterActivity удаляет пользовательские активности и их синонимы из реестра.
+// Встроенные активности не удаляются. Используется тестами, чтобы не оставлять
+// зарегистрированные названия между запусками.
+func unregisterActivity(names ...string) {
+	activitiesMu.Lock()
+	defer activitiesMu.Unlock()
+
+	for _, n := range names {
+		key := normalizeActivity(n)
+		if _, ok := canonicalActivity(key); ok {
+			continue
+		}
+		delete(activities, key)
+	}
+}
+
+// lookupActivity ищет вид активности в реестре. Синонимы встроенных активностей
+// предварительно приводятся к каноническому названию по ActivityAliases.
+func lookupActivity(activity string) (CalorieCalculator, bool) {
+	key := normalizeActivity(activity)
+	if kind, ok := canonicalActivity(activity); ok {
+		key = kind
+	}
+
+	activitiesMu.RLock()
+	defer activitiesMu.RUnlock()
+
+	calc, ok := activities[key]
+	return calc, ok
+}
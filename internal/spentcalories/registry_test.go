@@ -0,0 +1,93 @@
+package spentcalories
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestRegisterActivity() {
+	rowing := func(steps int, weight, height float64, duration time.Duration) (float64, error) {
+		return weight * duration.Hours() * 7, nil
+	}
+
+	assert.NoError(suite.T(), RegisterActivity("Гребля", rowing, "rowing"))
+	suite.T().Cleanup(func() { unregisterActivity("гребля", "rowing") })
+
+	got, err := TrainingInfo("6000,гребля,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Тип тренировки: гребля\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\nСкорость: 4.72 км/ч\nСожгли калорий: 525.00\n", got)
+
+	got, err = TrainingInfo("6000,Rowing,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Сожгли калорий: 525.00")
+
+	// Повторная регистрация, встроенные активности и некорректные аргументы отклоняются
+	assert.Error(suite.T(), RegisterActivity("гребля", rowing))
+	assert.Error(suite.T(), RegisterActivity("каяк", rowing, "ROWING"))
+	assert.Error(suite.T(), RegisterActivity("скакалка", rowing, "скакалка"))
+	assert.Error(suite.T(), RegisterActivity("бег", rowing))
+	assert.Error(suite.T(), RegisterActivity("", rowing))
+	assert.Error(suite.T(), RegisterActivity("скакалка", nil))
+
+	// Отклонённая регистрация не оставляет частично добавленных названий
+	_, ok := lookupActivity("каяк")
+	assert.False(suite.T(), ok)
+	_, ok = lookupActivity("скакалка")
+	assert.False(suite.T(), ok)
+}
+
+func (suite *SpentCaloriesTestSuite) TestRegisterActivityConcurrent() {
+	calcFn := func(steps int, weight, height float64, duration time.Duration) (float64, error) {
+		return 1, nil
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, 10)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = RegisterActivity(fmt.Sprintf("активность-%d", i), calcFn)
+		}(i)
+	}
+	wg.Wait()
+
+	suite.T().Cleanup(func() {
+		for i := range errs {
+			unregisterActivity(fmt.Sprintf("активность-%d", i))
+		}
+	})
+
+	for i, err := range errs {
+		assert.NoError(suite.T(), err)
+		_, ok := lookupActivity(fmt.Sprintf("активность-%d", i))
+		assert.True(suite.T(), ok)
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestBuiltinActivitiesInRegistry() {
+	tests := []struct {
+		name     string
+		activity string
+		want     CalorieCalculator
+	}{
+		{name: "бег", activity: "Бег", want: RunningCalculator{}},
+		{name: "синоним бега", activity: "running", want: RunningCalculator{}},
+		{name: "ходьба", activity: " ХОДЬБА ", want: WalkingCalculator{}},
+		{name: "велосипед", activity: "bike", want: CyclingCalculator{}},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, ok := lookupActivity(tt.activity)
+			assert.True(suite.T(), ok)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+
+	_, ok := lookupActivity("плавание")
+	assert.False(suite.T(), ok)
+}
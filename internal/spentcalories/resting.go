@@ -0,0 +1,68 @@
+package spentcalories
+
+import "time"
+
+// Коэффициенты формулы Миффлина — Сан Жеора для основного обмена в ккал/сутки.
+const (
+	bmrWeightFactor  = 10.0   // ккал на килограмм веса.
+	bmrHeightFactor  = 6.25   // ккал на сантиметр роста.
+	bmrAgeFactor     = 5.0    // ккал на год возраста.
+	bmrMaleOffset    = 5.0    // поправка для мужчин.
+	bmrFemaleOffset  = -161.0 // поправка для женщин.
+	bmrNeutralOffset = -78.0  // среднее между поправками для мужчин и женщин.
+	bmrDefaultAge    = 30     // возраст, используемый, если он не указан.
+	hoursInDay       = 24     // количество часов в сутках.
+)
+
+// RestingCalories оценивает калории, расходуемые в покое за duration,
+// по основному обмену по формуле Миффлина — Сан Жеора. Рост указывается в метрах.
+// Если пол не указан, используется среднее между мужской и женской формулой,
+// а если возраст не больше 0 — возраст 30 лет.
+func RestingCalories(weight, height float64, age int, sex Sex, duration time.Duration) float64 {
+	if weight <= 0 || height <= 0 || duration <= 0 {
+		return 0
+	}
+	if age <= 0 {
+		age = bmrDefaultAge
+	}
+
+	bmr := mifflinBMR(weight, height, age, sex)
+	if bmr < 0 {
+		return 0
+	}
+
+	// Переводим суточный обмен в расход за длительность тренировки
+	return bmr * duration.Hours() / hoursInDay
+}
+
+// NetCalories возвращает калории, сожжённые сверх основного обмена:
+// из activeCalories вычитается расход в покое за ту же длительность.
+func NetCalories(activeCalories, weight, height float64, age int, sex Sex, duration time.Duration) float64 {
+	return activeCalories - RestingCalories(weight, height, age, sex, duration)
+}
+
+// NetTrainingCalories рассчитывает калории тренировки из строки "шаги,активность,длительность"
+// и вычитает из них расход в покое за время тренировки.
+func NetTrainingCalories(data string, weight, height float64, age int, sex Sex) (float64, error) {
+	t, err := NewTraining(data, weight, height)
+	if err != nil {
+		return 0, err
+	}
+
+	return NetCalories(t.Calories, weight, height, age, sex, t.Duration), nil
+}
+
+// mifflinBMR возвращает основной обмен в ккал/сутки по формуле Миффлина — Сан Жеора.
+// Рост указывается в метрах.
+func mifflinBMR(weight, height float64, age int, sex Sex) float64 {
+	// Поправка формулы зависит от пола
+	offset := bmrNeutralOffset
+	switch sex {
+	case SexMale:
+		offset = bmrMaleOffset
+	case SexFemale:
+		offset = bmrFemaleOffset
+	}
+
+	return bmrWeightFactor*weight + bmrHeightFactor*height*cmInM - bmrAgeFactor*float64(age) + offset
+}
@@ -0,0 +1,99 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestRestingCalories() {
+	tests := []struct {
+		name     string
+		age      int
+		sex      Sex
+		duration time.Duration
+		want     float64
+	}{
+		{
+			name:     "мужчина, сутки",
+			age:      30,
+			sex:      SexMale,
+			duration: 24 * time.Hour,
+			want:     1698.75,
+		},
+		{
+			name:     "женщина, сутки",
+			age:      30,
+			sex:      SexFemale,
+			duration: 24 * time.Hour,
+			want:     1532.75,
+		},
+		{
+			name:     "пол и возраст не указаны",
+			age:      0,
+			sex:      SexUnknown,
+			duration: 24 * time.Hour,
+			want:     1615.75,
+		},
+		{
+			name:     "мужчина, один час",
+			age:      30,
+			sex:      SexMale,
+			duration: time.Hour,
+			want:     70.78125,
+		},
+		{
+			name:     "нулевая длительность",
+			age:      30,
+			sex:      SexMale,
+			duration: 0,
+			want:     0,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got := RestingCalories(75.0, 1.75, tt.age, tt.sex, tt.duration)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestNetCalories() {
+	got := NetCalories(354.375, 75.0, 1.75, 30, SexMale, time.Hour)
+	assert.InDelta(suite.T(), 283.59375, got, 1e-9)
+
+	got, err := NetTrainingCalories("6000,Бег,1h00m", 75.0, 1.75, 30, SexMale)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 283.59375, got, 1e-9)
+
+	_, err = NetTrainingCalories("6000,Плавание,1h00m", 75.0, 1.75, 30, SexMale)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *SpentCaloriesTestSuite) TestParseSex() {
+	tests := []struct {
+		input   string
+		want    Sex
+		wantErr bool
+	}{
+		{input: "", want: SexUnknown},
+		{input: "Male", want: SexMale},
+		{input: "ж", want: SexFemale},
+		{input: "x", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.input, func() {
+			got, err := ParseSex(tt.input)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package spentcalories
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// earthRadiusKm — средний радиус Земли в километрах.
+const earthRadiusKm = 6371.0
+
+// TrackPoint — точка маршрута.
+type TrackPoint struct {
+	Lat       float64   // широта в градусах.
+	Lon       float64   // долгота в градусах.
+	Elevation float64   // высота над уровнем моря в метрах.
+	Time      time.Time // время прохождения точки, если известно.
+}
+
+// HaversineKm возвращает расстояние по поверхности Земли между двумя точками в километрах.
+// Высота и время точек не учитываются.
+func HaversineKm(a, b TrackPoint) float64 {
+	lat1 := a.Lat * math.Pi / 180
+	lat2 := b.Lat * math.Pi / 180
+	dLat := lat2 - lat1
+	dLon := (b.Lon - a.Lon) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(math.Min(1, h)))
+}
+
+// segmentGrade возвращает уклон участка в процентах, ограниченный допустимым диапазоном.
+func segmentGrade(a, b TrackPoint, distanceKm float64) float64 {
+	if distanceKm <= 0 {
+		return 0
+	}
+
+	grade := (b.Elevation - a.Elevation) / (distanceKm * mInKm) * 100
+
+	return math.Max(-maxGradePercent, math.Min(maxGradePercent, grade))
+}
+
+// distanceCaloriesFactor возвращает калории на килограмм веса и километр дистанции.
+// Для бега это следует из формулы RunningSpentCalories, для ходьбы учитывается коэффициент ходьбы.
+func distanceCaloriesFactor(activity string) (float64, error) {
+	kind, _ := canonicalActivity(activity)
+	switch kind {
+	case activityRunning:
+		return 1, nil
+	case activityWalking:
+		return currentSettings().walkingCoefficient, nil
+	default:
+		return 0, unknownActivityError(activity)
+	}
+}
+
+// GradeAdjustedDistance возвращает эквивалентную дистанцию по ровной местности в километрах:
+// каждый участок маршрута учитывается с тем же множителем уклона, что и в расчёте калорий.
+// Подходит для сравнения темпа на холмистых и ровных трассах.
+func GradeAdjustedDistance(points []TrackPoint) (float64, error) {
+	if len(points) < 2 {
+		return 0, fmt.Errorf("маршрут должен содержать хотя бы две точки")
+	}
+
+	var adjusted float64
+	for i := 1; i < len(points); i++ {
+		// Длину участка корректируем с учётом его уклона
+		distanceKm := HaversineKm(points[i-1], points[i])
+		grade := segmentGrade(points[i-1], points[i], distanceKm)
+
+		adjusted += distanceKm * gradeMultiplier(grade)
+	}
+
+	return adjusted, nil
+}
+
+// RouteCalories рассчитывает калории для маршрута с учётом дистанции,
+// перепада высот на каждом участке и вида активности.
+func RouteCalories(points []TrackPoint, weight float64, activity string) (float64, error) {
+	// Проверка входных параметров
+	if len(points) < 2 {
+		return 0, fmt.Errorf("маршрут должен содержать хотя бы две точки")
+	}
+	if weight <= 0 {
+		return 0, fmt.Errorf("вес должен быть больше 0")
+	}
+
+	factor, err := distanceCaloriesFactor(activity)
+	if err != nil {
+		return 0, err
+	}
+
+	// Калории пропорциональны дистанции, скорректированной на уклон
+	adjusted, err := GradeAdjustedDistance(points)
+	if err != nil {
+		return 0, err
+	}
+
+	return weight * adjusted * factor, nil
+}
@@ -0,0 +1,112 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+// Точки вдоль меридиана: 0.009° широты — примерно 1 км.
+var (
+	flatRoute = []TrackPoint{
+		{Lat: 55.000, Lon: 37.0, Elevation: 100},
+		{Lat: 55.009, Lon: 37.0, Elevation: 100},
+		{Lat: 55.018, Lon: 37.0, Elevation: 100},
+	}
+	hillyRoute = []TrackPoint{
+		{Lat: 55.000, Lon: 37.0, Elevation: 100},
+		{Lat: 55.009, Lon: 37.0, Elevation: 150},
+		{Lat: 55.018, Lon: 37.0, Elevation: 100},
+	}
+)
+
+func (suite *SpentCaloriesTestSuite) TestHaversineKm() {
+	got := HaversineKm(flatRoute[0], flatRoute[1])
+	assert.InDelta(suite.T(), 1.0007, got, 0.001)
+}
+
+func (suite *SpentCaloriesTestSuite) TestRouteCalories() {
+	tests := []struct {
+		name     string
+		points   []TrackPoint
+		weight   float64
+		activity string
+		wantCal  float64
+		wantErr  bool
+	}{
+		{
+			name:     "бег по ровному маршруту",
+			points:   flatRoute,
+			weight:   75.0,
+			activity: "Бег",
+			wantCal:  150.1,
+			wantErr:  false,
+		},
+		{
+			name:     "бег по холмистому маршруту",
+			points:   hillyRoute,
+			weight:   75.0,
+			activity: "Бег",
+			wantCal:  165.1,
+			wantErr:  false,
+		},
+		{
+			name:     "ходьба по ровному маршруту",
+			points:   flatRoute,
+			weight:   75.0,
+			activity: "Ходьба",
+			wantCal:  75.05,
+			wantErr:  false,
+		},
+		{
+			name:     "одна точка",
+			points:   flatRoute[:1],
+			weight:   75.0,
+			activity: "Бег",
+			wantErr:  true,
+		},
+		{
+			name:     "нулевой вес",
+			points:   flatRoute,
+			weight:   0,
+			activity: "Бег",
+			wantErr:  true,
+		},
+		{
+			name:     "неизвестная активность",
+			points:   flatRoute,
+			weight:   75.0,
+			activity: "Плавание",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := RouteCalories(tt.points, tt.weight, tt.activity)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Equal(suite.T(), 0.0, got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.wantCal, got, 0.1)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestGradeAdjustedDistance() {
+	flat, err := GradeAdjustedDistance(flatRoute)
+	assert.NoError(suite.T(), err)
+
+	hilly, err := GradeAdjustedDistance(hillyRoute)
+	assert.NoError(suite.T(), err)
+
+	// Сырая дистанция маршрутов одинакова, но подъём делает холмистый маршрут длиннее
+	assert.InDelta(suite.T(), 2.0013, flat, 0.001)
+	assert.InDelta(suite.T(), 2.2013, hilly, 0.001)
+	assert.Greater(suite.T(), hilly, flat)
+
+	_, err = GradeAdjustedDistance(flatRoute[:1])
+	assert.Error(suite.T(), err)
+}
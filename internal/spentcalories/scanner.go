@@ -0,0 +1,83 @@
+package spentcalories
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Scanner построчно читает записи тренировок "шаги,активность,длительность"
+// из io.Reader, не загружая данные в память целиком. Пустые строки пропускаются.
+//
+// Использование повторяет bufio.Scanner:
+//
+//	s := spentcalories.NewScanner(os.Stdin)
+//	for s.Scan() {
+//		record := s.Record()
+//		...
+//	}
+//	if err := s.Err(); err != nil {
+//		...
+//	}
+type Scanner struct {
+	scanner *bufio.Scanner
+	line    int
+	record  TrainingRecord
+	err     error
+}
+
+// NewScanner создаёт Scanner, читающий записи из r.
+func NewScanner(r io.Reader) *Scanner {
+	return &Scanner{scanner: bufio.NewScanner(r)}
+}
+
+// Scan переходит к следующей записи, которая затем доступна через Record.
+// Возвращает false, когда записи закончились или произошла ошибка;
+// ошибку возвращает Err. Сканирование останавливается на первой некорректной записи.
+func (s *Scanner) Scan() bool {
+	if s.err != nil {
+		return false
+	}
+
+	for s.scanner.Scan() {
+		s.line++
+
+		// Пропускаем пустые строки и убираем перевод строки Windows
+		text := strings.TrimSuffix(s.scanner.Text(), "\r")
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+
+		steps, activity, duration, err := parseTraining(text)
+		if err != nil {
+			s.err = withLine(err, s.line)
+			s.record = TrainingRecord{}
+			return false
+		}
+
+		s.record = TrainingRecord{
+			Line:     s.line,
+			Steps:    steps,
+			Activity: activity,
+			Duration: duration,
+		}
+		return true
+	}
+
+	if err := s.scanner.Err(); err != nil {
+		s.err = fmt.Errorf("строка %d: ошибка чтения: %w", s.line+1, err)
+	}
+	s.record = TrainingRecord{}
+	return false
+}
+
+// Record возвращает запись, прочитанную последним вызовом Scan.
+func (s *Scanner) Record() TrainingRecord {
+	return s.record
+}
+
+// Err возвращает первую ошибку чтения или разбора. Для конца данных возвращается nil.
+func (s *Scanner) Err() error {
+	return s.err
+}
@@ -0,0 +1,91 @@
+package spentcalories
+
+import (
+	"errors"
+	"strings"
+	"testing/iotest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestScanner() {
+	tests := []struct {
+		name      string
+		input     string
+		want      []TrainingRecord
+		wantLine  int
+		wantField string
+	}{
+		{
+			name:  "корректные записи",
+			input: "6000,Бег,1h00m\n3456,Ходьба,3h00m",
+			want: []TrainingRecord{
+				{Line: 1, Steps: 6000, Activity: "Бег", Duration: time.Hour},
+				{Line: 2, Steps: 3456, Activity: "Ходьба", Duration: 3 * time.Hour},
+			},
+		},
+		{
+			name:  "пустые строки и перевод строки Windows",
+			input: "\r\n6000,Бег,1h00m\r\n   \n678,Ходьба,0.75h\r\n",
+			want: []TrainingRecord{
+				{Line: 2, Steps: 6000, Activity: "Бег", Duration: time.Hour},
+				{Line: 4, Steps: 678, Activity: "Ходьба", Duration: 45 * time.Minute},
+			},
+		},
+		{
+			name:  "пустой ввод",
+			input: "",
+			want:  nil,
+		},
+		{
+			name:      "некорректные шаги",
+			input:     "6000,Бег,1h00m\nabc,Бег,1h00m\n3456,Ходьба,3h00m\n",
+			want:      []TrainingRecord{{Line: 1, Steps: 6000, Activity: "Бег", Duration: time.Hour}},
+			wantLine:  2,
+			wantField: FieldSteps,
+		},
+		{
+			name:      "неверное количество полей",
+			input:     "\n\n6000,Бег\n",
+			wantLine:  3,
+			wantField: FieldRecord,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			s := NewScanner(strings.NewReader(tt.input))
+
+			var got []TrainingRecord
+			for s.Scan() {
+				got = append(got, s.Record())
+			}
+			assert.Equal(suite.T(), tt.want, got)
+
+			if tt.wantField == "" {
+				assert.NoError(suite.T(), s.Err())
+				return
+			}
+
+			var parseErr *ParseError
+			assert.True(suite.T(), errors.As(s.Err(), &parseErr))
+			assert.Equal(suite.T(), tt.wantLine, parseErr.Line)
+			assert.Equal(suite.T(), tt.wantField, parseErr.Field)
+
+			// После ошибки сканирование не продолжается
+			assert.False(suite.T(), s.Scan())
+			assert.Equal(suite.T(), TrainingRecord{}, s.Record())
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestScannerReadError() {
+	s := NewScanner(iotest.TimeoutReader(strings.NewReader("6000,Бег,1h00m\n3456,Ходьба,3h00m\n")))
+
+	// Первая порция данных читается, вторая возвращает ошибку
+	for s.Scan() {
+	}
+	assert.ErrorIs(suite.T(), s.Err(), iotest.ErrTimeout)
+	assert.ErrorContains(suite.T(), s.Err(), "ошибка чтения")
+}
@@ -0,0 +1,42 @@
+package spentcalories
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Sex — пол пользователя для формул, зависящих от пола.
+type Sex int
+
+// Возможные значения пола. SexUnknown используется, когда пол не указан.
+const (
+	SexUnknown Sex = iota
+	SexMale
+	SexFemale
+)
+
+// ParseSex разбирает пол из строки. Пустая строка означает SexUnknown.
+func ParseSex(s string) (Sex, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "":
+		return SexUnknown, nil
+	case "male", "m", "м", "мужской":
+		return SexMale, nil
+	case "female", "f", "ж", "женский":
+		return SexFemale, nil
+	default:
+		return SexUnknown, fmt.Errorf("неизвестный пол: %s", s)
+	}
+}
+
+// String возвращает название пола.
+func (s Sex) String() string {
+	switch s {
+	case SexMale:
+		return "male"
+	case SexFemale:
+		return "female"
+	default:
+		return "unknown"
+	}
+}
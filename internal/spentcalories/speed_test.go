@@ -0,0 +1,57 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestMeanSpeedMS() {
+	tests := []struct {
+		name      string
+		steps     int
+		height    float64
+		duration  time.Duration
+		wantSpeed float64
+	}{
+		{
+			name:      "нормальная скорость - один час",
+			steps:     6000,
+			height:    1.75,
+			duration:  1 * time.Hour,
+			wantSpeed: 1.3125,
+		},
+		{
+			name:      "большая скорость",
+			steps:     20000,
+			height:    1.75,
+			duration:  1 * time.Hour,
+			wantSpeed: 4.375,
+		},
+		{
+			name:      "нулевая продолжительность",
+			steps:     1000,
+			height:    1.75,
+			duration:  0,
+			wantSpeed: 0,
+		},
+		{
+			name:      "отрицательная продолжительность",
+			steps:     1000,
+			height:    1.75,
+			duration:  -1 * time.Hour,
+			wantSpeed: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := MeanSpeedMS(tt.steps, tt.height, tt.duration)
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.wantSpeed, got, 1e-9)
+		})
+	}
+
+	_, err := MeanSpeedMS(6000, 175, time.Hour)
+	assert.ErrorContains(suite.T(), err, "сантиметрах")
+}
@@ -2,10 +2,11 @@ package spentcalories
 
 import (
 	"fmt"
-	"log"
+	"math"
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
 )
 
 // Основные константы, необходимые для расчетов.
@@ -17,63 +18,85 @@ const (
 	walkingCaloriesCoefficient = 0.5  // коэффициент для расчета калорий при ходьбе
 )
 
+// ParseTraining разбирает строку тренировки "шаги,активность,длительность".
+// При любых входных данных функция возвращает ошибку, а не паникует.
+func ParseTraining(data string) (steps int, activity string, duration time.Duration, err error) {
+	return parseTraining(data)
+}
+
 func parseTraining(data string) (int, string, time.Duration, error) {
-	// Разделяем строку по запятой
-	parts := strings.Split(data, ",")
+	// Разделяем строку по запятой без выделения памяти под части
+	stepsRaw, activityRaw, durationRaw, ok := splitTraining(data)
 
 	// Проверяем, что у нас 3 части
-	if len(parts) != 3 {
-		return 0, "", 0, fmt.Errorf("неверный формат данных, ожидается 'шаги,активность,длительность'")
+	if !ok {
+		return 0, "", 0, newParseError(FieldRecord, data, fmt.Errorf("%w, ожидается 'шаги,активность,длительность'", ErrInvalidFormat))
 	}
 
+	return parseTrainingFields(stepsRaw, activityRaw, durationRaw)
+}
+
+// parseTrainingFields разбирает и проверяет поля записи тренировки: шаги, вид активности и длительность.
+func parseTrainingFields(stepsRaw, activityRaw, durationRaw string) (int, string, time.Duration, error) {
 	// Очищаем данные от пробелов
-	stepsStr := strings.TrimSpace(parts[0])
-	activity := strings.TrimSpace(parts[1])
-	durationStr := strings.TrimSpace(parts[2])
+	stepsStr := strings.TrimSpace(stepsRaw)
+	activity := strings.TrimSpace(activityRaw)
+	durationStr := strings.TrimSpace(durationRaw)
 
 	// Парсим количество шагов
 	steps, err := strconv.Atoi(stepsStr)
 	if err != nil {
-		return 0, "", 0, fmt.Errorf("неверный формат количества шагов: %v", err)
+		return 0, "", 0, newParseError(FieldSteps, stepsRaw, fmt.Errorf("неверный формат количества шагов: %w", err))
 	}
 
 	// Проверяем, что количество шагов больше 0
-	if steps <= 0 {
-		return 0, "", 0, fmt.Errorf("количество шагов должно быть больше 0")
+	if err := checkSteps(steps, stepsRaw); err != nil {
+		return 0, "", 0, err
 	}
 
 	// Проверяем, что вид активности не пустой
-	if activity == "" {
-		return 0, "", 0, fmt.Errorf("вид активности не может быть пустым")
+	if err := checkActivity(activity, activityRaw); err != nil {
+		return 0, "", 0, err
 	}
 
 	// Парсим длительность
-	duration, err := time.ParseDuration(durationStr)
+	duration, err := ParseDuration(durationStr)
 	if err != nil {
-		return 0, "", 0, fmt.Errorf("неверный формат длительности: %v", err)
+		return 0, "", 0, newParseError(FieldDuration, durationRaw, fmt.Errorf("неверный формат длительности: %w", err))
 	}
 
 	// Проверяем, что длительность больше 0
-	if duration <= 0 {
-		return 0, "", 0, fmt.Errorf("длительность должна быть больше 0")
+	if err := checkDuration(duration, durationRaw); err != nil {
+		return 0, "", 0, err
 	}
 
 	return steps, activity, duration, nil
 }
 
-func distance(steps int, height float64) float64 {
-	// Рассчитываем длину шага на основе роста
-	stepLength := height * stepLengthCoefficient
+func checkSteps(steps int, raw string) error {
+	if steps <= 0 {
+		return newParseError(FieldSteps, raw, ErrNonPositiveSteps)
+	}
+	return nil
+}
 
-	// Если рассчитанная длина шага слишком мала или отрицательная,
-	// используем среднюю длину шага
-	if stepLength <= 0 {
-		stepLength = lenStep
+func checkActivity(activity, raw string) error {
+	if activity == "" {
+		return newParseError(FieldActivity, raw, fmt.Errorf("вид активности не может быть пустым"))
+	}
+	return nil
+}
+
+func checkDuration(duration time.Duration, raw string) error {
+	if duration <= 0 {
+		return newParseError(FieldDuration, raw, ErrNonPositiveDuration)
 	}
+	return nil
+}
 
-	// Вычисляем дистанцию в метрах и переводим в километры
-	distanceMeters := float64(steps) * stepLength
-	return distanceMeters / mInKm
+func distance(steps int, height float64) float64 {
+	stepLength, _ := staticStepLength(height)
+	return float64(steps) * stepLength / mInKm
 }
 
 func meanSpeed(steps int, height float64, duration time.Duration) float64 {
@@ -96,27 +119,74 @@ func meanSpeed(steps int, height float64, duration time.Duration) float64 {
 	return dist / hours
 }
 
-func RunningSpentCalories(steps int, weight, height float64, duration time.Duration) (float64, error) {
-	// Проверка входных параметров
-	if steps <= 0 {
-		return 0, fmt.Errorf("количество шагов должно быть больше 0")
-	}
-	if weight <= 0 {
-		return 0, fmt.Errorf("вес должен быть больше 0")
+// stepSpeed возвращает среднюю скорость в км/ч для шагов заданной длины в метрах.
+func stepSpeed(steps int, stepLength float64, duration time.Duration) float64 {
+	hours := duration.Hours()
+	if hours <= 0 {
+		return 0
 	}
-	if height <= 0 {
-		return 0, fmt.Errorf("рост должен быть больше 0")
+
+	return float64(steps) * stepLength / mInKm / hours
+}
+
+// MeanSpeedMS возвращает среднюю скорость в метрах в секунду,
+// вычисленную напрямую из дистанции в метрах и длительности в секундах.
+// Для неположительной длительности возвращается 0, для роста вне диапазона
+// 0,5–2,5 м — ошибка.
+func MeanSpeedMS(steps int, height float64, duration time.Duration) (float64, error) {
+	if err := validateHeight(height); err != nil {
+		return 0, err
 	}
+
+	// Проверяем, что продолжительность больше 0
 	if duration <= 0 {
-		return 0, fmt.Errorf("длительность должна быть больше 0")
+		return 0, nil
+	}
+
+	// Вычисляем дистанцию в метрах
+	distanceMeters := distance(steps, height) * mInKm
+
+	return distanceMeters / duration.Seconds(), nil
+}
+
+// maxSteps — наибольшее правдоподобное количество шагов за одну тренировку.
+// Большие значения отклоняются до перевода в float64, чтобы в расчетах
+// не появлялись потеря точности, +Inf или NaN.
+const maxSteps = 1_000_000
+
+func validateSteps(steps int) error {
+	if steps <= 0 {
+		return ErrNonPositiveSteps
 	}
+	if steps > maxSteps {
+		return fmt.Errorf("количество шагов %d превышает допустимое значение %d", steps, maxSteps)
+	}
+	return nil
+}
 
+func RunningSpentCalories(steps int, weight, height float64, duration time.Duration) (float64, error) {
+	// Проверка входных параметров
+	if err := validateTrainingInputs(steps, weight, height, duration); err != nil {
+		return 0, err
+	}
+
+	return runningCalories(steps, weight, strideLength(height, 0), duration)
+}
+
+// runningCalories рассчитывает калории бега по длине шага в метрах.
+// Входные параметры должны быть проверены вызывающим кодом.
+func runningCalories(steps int, weight, stepLength float64, duration time.Duration) (float64, error) {
 	// Рассчитываем среднюю скорость
-	speed := meanSpeed(steps, height, duration)
+	speed := stepSpeed(steps, stepLength, duration)
 	if speed <= 0 {
 		return 0, fmt.Errorf("не удалось рассчитать скорость")
 	}
 
+	// При расчете по таблице MET используем среднюю скорость для выбора MET
+	if currentSettings().calorieMode == CalorieModeMET {
+		return CaloriesMET(activityRunning, weight, duration, speed)
+	}
+
 	// Переводим продолжительность в минуты
 	minutes := duration.Minutes()
 
@@ -128,83 +198,268 @@ func RunningSpentCalories(steps int, weight, height float64, duration time.Durat
 
 func WalkingSpentCalories(steps int, weight, height float64, duration time.Duration) (float64, error) {
 	// Проверка входных параметров
-	if steps <= 0 {
-		return 0, fmt.Errorf("количество шагов должно быть больше 0")
-	}
-	if weight <= 0 {
-		return 0, fmt.Errorf("вес должен быть больше 0")
-	}
-	if height <= 0 {
-		return 0, fmt.Errorf("рост должен быть больше 0")
-	}
-	if duration <= 0 {
-		return 0, fmt.Errorf("длительность должна быть больше 0")
+	if err := validateTrainingInputs(steps, weight, height, duration); err != nil {
+		return 0, err
 	}
 
+	return walkingCalories(steps, weight, strideLength(height, 0), duration)
+}
+
+// walkingCalories рассчитывает калории ходьбы по длине шага в метрах.
+// Входные параметры должны быть проверены вызывающим кодом.
+func walkingCalories(steps int, weight, stepLength float64, duration time.Duration) (float64, error) {
 	// Рассчитываем среднюю скорость
-	speed := meanSpeed(steps, height, duration)
+	speed := stepSpeed(steps, stepLength, duration)
 	if speed <= 0 {
 		return 0, fmt.Errorf("не удалось рассчитать скорость")
 	}
 
+	// Читаем параметры один раз, чтобы расчет не зависел от их одновременного изменения
+	cfg := currentSettings()
+
+	// При расчете по таблице MET используем среднюю скорость для выбора MET
+	if cfg.calorieMode == CalorieModeMET {
+		return CaloriesMET(activityWalking, weight, duration, speed)
+	}
+
 	// Переводим продолжительность в минуты
 	minutes := duration.Minutes()
 
 	// Рассчитываем калории
 	calories := (weight * speed * minutes) / minInH
-	calories = calories * walkingCaloriesCoefficient
+	calories = calories * cfg.walkingCoefficient
+
+	return calories, nil
+}
+
+// Канонические названия видов активности.
+const (
+	activityRunning = "бег"
+	activityWalking = "ходьба"
+	activityCycling = "велосипед"
+)
+
+// normalizeActivity приводит название активности к нижнему регистру,
+// убирает пробелы и знаки препинания по краям и схлопывает повторяющиеся пробелы внутри.
+// Пробелы внутри слова не удаляются: "run ning" остаётся неизвестной активностью,
+// а unknownActivityError подсказывает слитное написание.
+func normalizeActivity(activity string) string {
+	activity = strings.TrimFunc(activity, func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsPunct(r)
+	})
+
+	return strings.Join(strings.Fields(strings.ToLower(activity)), " ")
+}
+
+// unknownActivityError возвращает ErrUnknownActivity с названием активности. Если название
+// без пробелов совпадает с известной активностью, например "run ning", в ошибку
+// добавляется подсказка.
+func unknownActivityError(activity string) error {
+	joined := strings.ReplaceAll(normalizeActivity(activity), " ", "")
+	if joined != normalizeActivity(activity) {
+		if _, ok := lookupActivity(joined); ok {
+			return fmt.Errorf("%w: %s (возможно, имелось в виду %q)", ErrUnknownActivity, activity, joined)
+		}
+	}
+
+	return fmt.Errorf("%w: %s", ErrUnknownActivity, activity)
+}
+
+// ActivityAliases сопоставляет названия и синонимы встроенных видов активности
+// с каноническими названиями "бег", "ходьба" и "велосипед". Ключи указываются в нижнем регистре
+// с одиночными пробелами. Таблицу можно дополнить при инициализации программы,
+// например ActivityAliases["jogging"] = "бег"; изменять её одновременно с расчетами
+// из других горутин небезопасно.
+var ActivityAliases = map[string]string{
+	"бег":     activityRunning,
+	"running": activityRunning,
+	"run":     activityRunning,
+	"ходьба":  activityWalking,
+	"walking": activityWalking,
+	"walk":    activityWalking,
+
+	"велосипед": activityCycling,
+	"cycling":   activityCycling,
+	"bike":      activityCycling,
+}
+
+func canonicalActivity(activity string) (string, bool) {
+	// Приводим синонимы к каноническому названию активности по таблице
+	kind, ok := ActivityAliases[normalizeActivity(activity)]
+	if !ok {
+		return "", false
+	}
+
+	// Синоним должен указывать на встроенный вид активности
+	switch kind {
+	case activityRunning, activityWalking, activityCycling:
+		return kind, true
+	default:
+		return "", false
+	}
+}
+
+// trainingDistance возвращает дистанцию тренировки в километрах. Для велосипеда
+// первое поле записи — обороты педалей, для остальных активностей — шаги длиной
+// stepLength метров или, если длина шага не задана, рассчитанной по росту.
+func trainingDistance(activity string, steps int, height, stepLength float64) float64 {
+	if kind, _ := canonicalActivity(activity); kind == activityCycling {
+		return cyclingDistance(steps)
+	}
+
+	return float64(steps) * strideLength(height, stepLength) / mInKm
+}
+
+// StepsForDistance возвращает количество шагов, за которое проходится дистанция km
+// при заданном росте, а для велосипеда — количество оборотов педалей. Позволяет
+// рассчитать калории для дистанции, измеренной без шагомера, например по GPS.
+// Рост вне диапазона 0,5–2,5 м возвращает ошибку.
+func StepsForDistance(activity string, km, height float64) (int, error) {
+	if err := validateHeight(height); err != nil {
+		return 0, err
+	}
+	if km <= 0 {
+		return 0, nil
+	}
+
+	metersPerStep, _ := staticStepLength(height)
+	if kind, _ := canonicalActivity(activity); kind == activityCycling {
+		metersPerStep = metersPerPedalRev
+	}
+
+	return int(math.Round(km * mInKm / metersPerStep)), nil
+}
+
+// trainingSpeed возвращает среднюю скорость тренировки в км/ч с учётом вида активности.
+func trainingSpeed(activity string, steps int, height, stepLength float64, duration time.Duration) float64 {
+	if duration <= 0 {
+		return 0
+	}
+
+	return trainingDistance(activity, steps, height, stepLength) / duration.Hours()
+}
+
+// trainingCalories рассчитывает калории тренировки. Измеренная длина шага учитывается
+// для встроенных бега и ходьбы; остальные активности рассчитываются по росту.
+func trainingCalories(activity string, steps int, weight, height, stepLength float64, duration time.Duration) (float64, error) {
+	if stepLength <= 0 {
+		return spentCalories(activity, steps, weight, height, duration)
+	}
+
+	kind, _ := canonicalActivity(activity)
+	switch kind {
+	case activityRunning, activityWalking:
+		if err := validateTrainingInputs(steps, weight, height, duration); err != nil {
+			return 0, err
+		}
+		if kind == activityRunning {
+			return runningCalories(steps, weight, stepLength, duration)
+		}
+		return walkingCalories(steps, weight, stepLength, duration)
+	default:
+		return spentCalories(activity, steps, weight, height, duration)
+	}
+}
+
+func spentCalories(activity string, steps int, weight, height float64, duration time.Duration) (float64, error) {
+	// Выбираем стратегию расчета калорий по типу активности
+	calc, err := CalculatorFor(activity)
+	if err != nil {
+		return 0, err
+	}
+
+	calories, err := calc.SpentCalories(steps, weight, height, duration)
+	if err != nil {
+		return 0, err
+	}
+
+	// Не допускаем NaN и бесконечность в отчётах
+	if math.IsNaN(calories) || math.IsInf(calories, 0) {
+		return 0, fmt.Errorf("некорректный результат расчета калорий: %v", calories)
+	}
 
 	return calories, nil
 }
 
 func TrainingInfo(data string, weight, height float64) (string, error) {
-	// Получаем данные о тренировке
-	steps, activity, duration, err := parseTraining(data)
+	result, err := trainingInfo(data, weight, height)
 	if err != nil {
-		log.Println("Ошибка парсинга данных:", err)
 		return "", err
 	}
 
-	// Проверяем вес и рост
-	if weight <= 0 {
-		return "", fmt.Errorf("вес должен быть больше 0")
+	return result.String(), nil
+}
+
+// TrainingInfoStruct работает как TrainingInfo, но возвращает показатели тренировки
+// в виде структуры, а не отформатированной строки.
+func TrainingInfoStruct(data string, weight, height float64) (TrainingSummary, error) {
+	return trainingInfo(data, weight, height)
+}
+
+func trainingInfo(data string, weight, height float64) (TrainingResult, error) {
+	// Запись плавания содержит круги и длину бассейна вместо шагов
+	if result, ok, err := swimmingTraining(data, weight); ok {
+		if err != nil {
+			logger().Error("ошибка расчета плавания", "record", data, "error", err)
+			return TrainingResult{}, err
+		}
+		return result, nil
 	}
-	if height <= 0 {
-		return "", fmt.Errorf("рост должен быть больше 0")
+
+	// Получаем данные о тренировке
+	steps, activity, duration, err := parseTraining(data)
+	if err != nil {
+		logger().Error("ошибка разбора данных", "record", data, "error", err)
+		return TrainingResult{}, err
 	}
 
-	var calories float64
-	var caloriesErr error
+	return trainingResult(activity, steps, duration, weight, height, 0)
+}
 
-	// Выбираем расчет калорий в зависимости от типа активности
-	switch strings.ToLower(activity) {
-	case "бег", "running", "run":
-		calories, caloriesErr = RunningSpentCalories(steps, weight, height, duration)
-	case "ходьба", "walking", "walk":
-		calories, caloriesErr = WalkingSpentCalories(steps, weight, height, duration)
-	default:
-		return "", fmt.Errorf("неизвестный тип тренировки: %s", activity)
+// trainingResult рассчитывает показатели разобранной тренировки по шагам.
+// Если stepLength больше 0, дистанция, скорость и калории бега и ходьбы рассчитываются
+// по этой измеренной длине шага в метрах, а не по росту.
+func trainingResult(activity string, steps int, duration time.Duration, weight, height, stepLength float64) (TrainingResult, error) {
+	// Проверяем вес, рост и длину шага
+	if weight <= 0 {
+		return TrainingResult{}, fmt.Errorf("вес должен быть больше 0")
+	}
+	if err := validateHeight(height); err != nil {
+		return TrainingResult{}, err
+	}
+	if stepLength != 0 {
+		if err := validateStepLength(stepLength); err != nil {
+			return TrainingResult{}, err
+		}
 	}
 
-	// Проверяем ошибку расчета калорий
-	if caloriesErr != nil {
-		log.Println("Ошибка расчета калорий:", caloriesErr)
-		return "", caloriesErr
+	// Рассчитываем калории в зависимости от типа активности
+	calories, err := trainingCalories(activity, steps, weight, height, stepLength, duration)
+	if err != nil {
+		logger().Error("ошибка расчета калорий", "activity", activity, "error", err)
+		return TrainingResult{}, err
 	}
 
 	// Рассчитываем дистанцию и среднюю скорость
-	dist := distance(steps, height)
-	speed := meanSpeed(steps, height, duration)
-
-	// Форматируем строку результата
-	result := fmt.Sprintf(
-		"Тип тренировки: %s\nДлительность: %.2f ч.\nДистанция: %.2f км.\nСкорость: %.2f км/ч\nСожгли калорий: %.2f\n",
-		activity,
-		duration.Hours(),
-		dist,
-		speed,
-		calories,
-	)
+	result := TrainingResult{
+		Activity: activity,
+		Steps:    steps,
+		Duration: duration,
+		Distance: trainingDistance(activity, steps, height, stepLength),
+		Speed:    trainingSpeed(activity, steps, height, stepLength, duration),
+		Calories: calories,
+	}
 
 	return result, nil
 }
+
+// IsStepActivity сообщает, содержит ли первое поле записи активности шаги.
+// Для велосипеда в нём указываются обороты педалей, для плавания — круги.
+func IsStepActivity(activity string) bool {
+	if isSwimming(activity) {
+		return false
+	}
+
+	kind, _ := canonicalActivity(activity)
+	return kind != activityCycling
+}
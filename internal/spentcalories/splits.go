@@ -0,0 +1,56 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+)
+
+// splitEpsilon — остаток дистанции в километрах, который не выделяется в отдельный отрезок.
+const splitEpsilon = 1e-9
+
+// Split — отрезок тренировки при разбиении на равные части.
+type Split struct {
+	Distance   float64       // дистанция отрезка в километрах.
+	Cumulative float64       // дистанция от старта до конца отрезка в километрах.
+	Duration   time.Duration // продолжительность отрезка.
+	Pace       float64       // темп отрезка в минутах на километр.
+}
+
+// Splits разбивает тренировку на отрезки по splitKm километров, считая скорость постоянной.
+// Последний отрезок может быть короче и содержит фактическую оставшуюся дистанцию.
+func Splits(steps int, height float64, duration time.Duration, splitKm float64) ([]Split, error) {
+	// Проверка входных параметров
+	if splitKm <= 0 {
+		return nil, fmt.Errorf("длина отрезка должна быть больше 0")
+	}
+	if err := validateSteps(steps); err != nil {
+		return nil, err
+	}
+	if err := validateHeight(height); err != nil {
+		return nil, err
+	}
+	if duration <= 0 {
+		return nil, ErrNonPositiveDuration
+	}
+
+	total := distance(steps, height)
+	pace := duration.Minutes() / total
+
+	var result []Split
+	for start := 0.0; total-start > splitEpsilon; {
+		// Конец отрезка считаем от старта, чтобы не накапливать погрешность
+		end := min(float64(len(result)+1)*splitKm, total)
+		length := end - start
+
+		result = append(result, Split{
+			Distance:   length,
+			Cumulative: end,
+			Duration:   time.Duration(float64(duration) * length / total),
+			Pace:       pace,
+		})
+
+		start = end
+	}
+
+	return result, nil
+}
@@ -0,0 +1,44 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestSplits() {
+	got, err := Splits(6000, 1.75, time.Hour, 1)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), got, 5)
+
+	// Полные отрезки по одному километру
+	for i, s := range got[:4] {
+		assert.InDelta(suite.T(), 1.0, s.Distance, 1e-9)
+		assert.InDelta(suite.T(), float64(i+1), s.Cumulative, 1e-9)
+		assert.InDelta(suite.T(), 12.698, s.Pace, 0.001)
+		assert.InDelta(suite.T(), float64(761904761904), float64(s.Duration), 1e3)
+	}
+
+	// Последний отрезок короче
+	last := got[4]
+	assert.InDelta(suite.T(), 0.725, last.Distance, 1e-9)
+	assert.InDelta(suite.T(), 4.725, last.Cumulative, 1e-9)
+
+	var total time.Duration
+	for _, s := range got {
+		total += s.Duration
+	}
+	assert.InDelta(suite.T(), float64(time.Hour), float64(total), float64(time.Microsecond))
+
+	// Отрезки по полкилометра
+	got, err = Splits(4000, 1.25, time.Hour, 0.5)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), got, 5)
+	assert.InDelta(suite.T(), 0.25, got[4].Distance, 1e-9)
+
+	_, err = Splits(6000, 1.75, time.Hour, 0)
+	assert.Error(suite.T(), err)
+
+	_, err = Splits(6000, 1.75, 0, 1)
+	assert.Error(suite.T(), err)
+}
@@ -0,0 +1,110 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+)
+
+// Параметры модели длины шага, зависящей от скорости.
+const (
+	stepLengthBaseSpeed = 5.0  // скорость в км/ч, до которой длина шага равна статической оценке.
+	stepLengthSpeedGain = 0.05 // относительный прирост длины шага на каждый км/ч выше базовой скорости.
+	maxStepLengthGrowth = 1.5  // максимальное отношение длины шага к статической оценке.
+)
+
+// maxStepLength — наибольшая правдоподобная измеренная длина шага в метрах.
+const maxStepLength = 2.0
+
+// staticStepLength возвращает длину шага в метрах, рассчитанную по росту, и признак того,
+// что вместо неё использована средняя длина шага: рассчитанное значение неположительно
+// или меньше минимальной длины шага, заданной SetMinStepLength.
+func staticStepLength(height float64) (float64, bool) {
+	// Рассчитываем длину шага на основе роста
+	stepLength := height * stepLengthCoefficient
+
+	// Если рассчитанная длина шага слишком мала или отрицательная,
+	// используем среднюю длину шага
+	if stepLength <= 0 || stepLength < currentSettings().minStepLength {
+		return lenStep, true
+	}
+
+	return stepLength, false
+}
+
+// Distance возвращает дистанцию в километрах для заданного количества шагов и роста
+// и сообщает, использовалась ли средняя длина шага вместо рассчитанной по росту.
+// Признак usedFallback позволяет предупредить, что дистанция является оценкой.
+// Рост вне диапазона 0,5–2,5 м, например в сантиметрах, возвращает ошибку.
+func Distance(steps int, height float64) (km float64, usedFallback bool, err error) {
+	if err := validateHeight(height); err != nil {
+		return 0, false, err
+	}
+
+	stepLength, usedFallback := staticStepLength(height)
+
+	// Вычисляем дистанцию в метрах и переводим в километры
+	return float64(steps) * stepLength / mInKm, usedFallback, nil
+}
+
+// DynamicStepLength возвращает длину шага в метрах с учётом скорости.
+// До 5 км/ч длина шага совпадает со статической оценкой по росту, а выше
+// увеличивается на 5% за каждый км/ч, но не более чем в 1,5 раза.
+// Рост вне диапазона 0,5–2,5 м возвращает ошибку.
+func DynamicStepLength(height, speedKmH float64) (float64, error) {
+	if err := validateHeight(height); err != nil {
+		return 0, err
+	}
+
+	return dynamicStepLength(height, speedKmH), nil
+}
+
+// dynamicStepLength рассчитывает длину шага с учётом скорости по проверенному росту.
+func dynamicStepLength(height, speedKmH float64) float64 {
+	// Статическая оценка длины шага по росту
+	stepLength, _ := staticStepLength(height)
+
+	if speedKmH <= stepLengthBaseSpeed {
+		return stepLength
+	}
+
+	// Увеличиваем длину шага пропорционально превышению базовой скорости
+	growth := 1 + stepLengthSpeedGain*(speedKmH-stepLengthBaseSpeed)
+	if growth > maxStepLengthGrowth {
+		growth = maxStepLengthGrowth
+	}
+
+	return stepLength * growth
+}
+
+// DistanceDynamic возвращает дистанцию в километрах с длиной шага из DynamicStepLength.
+// Скорость для модели оценивается по статической длине шага.
+// Рост вне диапазона 0,5–2,5 м возвращает ошибку.
+func DistanceDynamic(steps int, height float64, duration time.Duration) (float64, error) {
+	if err := validateHeight(height); err != nil {
+		return 0, err
+	}
+
+	// Оцениваем скорость по статической длине шага
+	speed := meanSpeed(steps, height, duration)
+
+	return float64(steps) * dynamicStepLength(height, speed) / mInKm, nil
+}
+
+// validateStepLength проверяет измеренную длину шага в метрах.
+func validateStepLength(stepLength float64) error {
+	if stepLength <= 0 || stepLength > maxStepLength {
+		return fmt.Errorf("длина шага %.2f м вне допустимого диапазона (0, %.1f]", stepLength, maxStepLength)
+	}
+	return nil
+}
+
+// strideLength возвращает длину шага для расчета: измеренную, если она задана,
+// иначе рассчитанную по росту.
+func strideLength(height, stepLength float64) float64 {
+	if stepLength > 0 {
+		return stepLength
+	}
+
+	m, _ := staticStepLength(height)
+	return m
+}
@@ -0,0 +1,173 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestDynamicStepLength() {
+	slow, err := DynamicStepLength(1.75, 3.0)
+	assert.NoError(suite.T(), err)
+	fast, err := DynamicStepLength(1.75, 12.0)
+	assert.NoError(suite.T(), err)
+
+	assert.InDelta(suite.T(), 0.7875, slow, 1e-9)
+	assert.InDelta(suite.T(), 1.063125, fast, 1e-9)
+	assert.Greater(suite.T(), fast, slow)
+
+	// Рост длины шага ограничен сверху
+	capped, err := DynamicStepLength(1.75, 40.0)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 0.7875*1.5, capped, 1e-9)
+
+	_, err = DynamicStepLength(175, 3.0)
+	assert.ErrorContains(suite.T(), err, "сантиметрах")
+}
+
+func (suite *SpentCaloriesTestSuite) TestDistanceDynamic() {
+	tests := []struct {
+		name     string
+		steps    int
+		duration time.Duration
+		want     float64
+	}{
+		{
+			name:     "медленная ходьба - как статическая оценка",
+			steps:    6000,
+			duration: time.Hour,
+			want:     4.725,
+		},
+		{
+			name:     "быстрый бег - шаг длиннее",
+			steps:    6000,
+			duration: 30 * time.Minute,
+			want:     5.7763125,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := DistanceDynamic(tt.steps, 1.75, tt.duration)
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+
+	_, err := DistanceDynamic(6000, 175, time.Hour)
+	assert.ErrorContains(suite.T(), err, "сантиметрах")
+}
+
+func (suite *SpentCaloriesTestSuite) TestDistanceFallback() {
+	defer func() {
+		_ = SetMinStepLength(0)
+	}()
+
+	tests := []struct {
+		name         string
+		height       float64
+		minStep      float64
+		wantKm       float64
+		wantFallback bool
+		wantErr      bool
+	}{
+		{
+			name:         "обычный рост без ограничения",
+			height:       1.75,
+			minStep:      0,
+			wantKm:       4.725,
+			wantFallback: false,
+		},
+		{
+			name:         "низкий рост без ограничения",
+			height:       0.9,
+			minStep:      0,
+			wantKm:       2.43,
+			wantFallback: false,
+		},
+		{
+			name:         "низкий рост с ограничением",
+			height:       0.9,
+			minStep:      0.5,
+			wantKm:       3.9,
+			wantFallback: true,
+		},
+		{
+			name:         "обычный рост с ограничением",
+			height:       1.75,
+			minStep:      0.5,
+			wantKm:       4.725,
+			wantFallback: false,
+		},
+		{
+			name:    "нулевой рост",
+			height:  0,
+			minStep: 0,
+			wantErr: true,
+		},
+		{
+			name:    "рост в сантиметрах",
+			height:  170,
+			minStep: 0,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.NoError(suite.T(), SetMinStepLength(tt.minStep))
+
+			km, usedFallback, err := Distance(6000, tt.height)
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.wantKm, km, 1e-9)
+			assert.Equal(suite.T(), tt.wantFallback, usedFallback)
+		})
+	}
+
+	assert.Error(suite.T(), SetMinStepLength(-0.1))
+	assert.Error(suite.T(), SetMinStepLength(0.8))
+}
+
+func (suite *SpentCaloriesTestSuite) TestNewTrainingWithStepLength() {
+	got, err := NewTrainingWithStepLength("6000,Бег,1h00m", 75.0, 1.75, 0.8)
+	assert.NoError(suite.T(), err)
+
+	// Рост больше не влияет на дистанцию
+	assert.InDelta(suite.T(), 4.8, got.Distance, 1e-9)
+	assert.InDelta(suite.T(), 4.8, got.Speed, 1e-9)
+	assert.InDelta(suite.T(), 75*4.8, got.Calories, 1e-9)
+
+	walk, err := NewTrainingWithStepLength("6000,Ходьба,1h00m", 75.0, 1.75, 0.8)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 75*4.8*0.5, walk.Calories, 1e-9)
+
+	// Длинный шаг не требует выдуманного роста
+	long, err := NewTrainingWithStepLength("6000,Бег,1h00m", 75.0, 1.75, 1.2)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 7.2, long.Distance, 1e-9)
+
+	// Велосипед считается по оборотам педалей
+	bike, err := NewTrainingWithStepLength("1500,Велосипед,20m", 75.0, 1.75, 0.8)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 9, bike.Distance, 1e-9)
+
+	// Нулевая длина шага — расчет по росту
+	got, err = NewTrainingWithStepLength("6000,Бег,1h00m", 75.0, 1.75, 0)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 4.725, got.Distance, 1e-9)
+
+	_, err = NewTrainingWithStepLength("6000,Бег,1h00m", 75.0, 1.75, -0.1)
+	assert.ErrorContains(suite.T(), err, "длина шага")
+	_, err = NewTrainingWithStepLength("6000,Бег,1h00m", 75.0, 1.75, 2.5)
+	assert.ErrorContains(suite.T(), err, "длина шага")
+
+	// Расчет по росту не зависит от предыдущих вызовов
+	km, _, err := Distance(6000, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 4.725, km, 1e-9)
+}
@@ -0,0 +1,142 @@
+package spentcalories
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// TrainingEntry — тренировка с датой и рассчитанными показателями.
+type TrainingEntry struct {
+	Date     time.Time     // дата и время тренировки.
+	Steps    int           // количество шагов.
+	Activity string        // вид активности.
+	Duration time.Duration // продолжительность тренировки.
+	Distance float64       // дистанция в километрах.
+	Calories float64       // количество сожжённых калорий.
+}
+
+// PeriodSummary — суммарные показатели тренировок за неделю или месяц.
+type PeriodSummary struct {
+	Key      string        // ключ периода: "2006-W01" для недели или "2006-01" для месяца.
+	Start    time.Time     // начало периода по местному времени записей.
+	Distance float64       // суммарная дистанция в километрах.
+	Calories float64       // суммарное количество калорий.
+	Duration time.Duration // суммарная продолжительность.
+}
+
+// NewTrainingEntry разбирает строку тренировки и рассчитывает её показатели.
+func NewTrainingEntry(date time.Time, data string, weight, height float64) (TrainingEntry, error) {
+	t, err := NewTraining(data, weight, height)
+	if err != nil {
+		return TrainingEntry{}, err
+	}
+
+	return TrainingEntry{
+		Date:     date,
+		Steps:    t.Steps,
+		Activity: t.Activity,
+		Duration: t.Duration,
+		Distance: t.Distance,
+		Calories: t.Calories,
+	}, nil
+}
+
+// WeeklySummary группирует тренировки по ISO-неделям в хронологическом порядке.
+func WeeklySummary(entries []TrainingEntry) []PeriodSummary {
+	return summarize(entries, func(t time.Time) (string, time.Time) {
+		year, week := t.ISOWeek()
+
+		// Неделя по ISO начинается с понедельника
+		offset := (int(t.Weekday()) + 6) % 7
+		start := time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, t.Location())
+
+		return fmt.Sprintf("%04d-W%02d", year, week), start
+	})
+}
+
+// MonthlySummary группирует тренировки по календарным месяцам в хронологическом порядке.
+func MonthlySummary(entries []TrainingEntry) []PeriodSummary {
+	return summarize(entries, func(t time.Time) (string, time.Time) {
+		start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+		return start.Format("2006-01"), start
+	})
+}
+
+func summarize(entries []TrainingEntry, period func(time.Time) (string, time.Time)) []PeriodSummary {
+	buckets := make(map[string]*PeriodSummary)
+
+	for _, e := range entries {
+		// Определяем период по местной дате записи
+		key, start := period(e.Date)
+
+		bucket, ok := buckets[key]
+		if !ok {
+			bucket = &PeriodSummary{Key: key, Start: start}
+			buckets[key] = bucket
+		}
+
+		bucket.Distance += e.Distance
+		bucket.Calories += e.Calories
+		bucket.Duration += e.Duration
+	}
+
+	result := make([]PeriodSummary, 0, len(buckets))
+	for _, bucket := range buckets {
+		result = append(result, *bucket)
+	}
+
+	// Сортируем периоды в хронологическом порядке
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Start.Before(result[j].Start)
+	})
+
+	return result
+}
+
+// trainingEntryJSON — представление TrainingEntry в JSON с длительностью в виде строки.
+type trainingEntryJSON struct {
+	Date     time.Time `json:"date"`
+	Steps    int       `json:"steps"`
+	Activity string    `json:"activity"`
+	Duration string    `json:"duration"`
+	Distance float64   `json:"distance"`
+	Calories float64   `json:"calories"`
+}
+
+// MarshalJSON сохраняет запись в JSON; длительность записывается строкой, например "1h30m0s".
+func (e TrainingEntry) MarshalJSON() ([]byte, error) {
+	return json.Marshal(trainingEntryJSON{
+		Date:     e.Date,
+		Steps:    e.Steps,
+		Activity: e.Activity,
+		Duration: e.Duration.String(),
+		Distance: e.Distance,
+		Calories: e.Calories,
+	})
+}
+
+// UnmarshalJSON восстанавливает запись, сохранённую MarshalJSON.
+func (e *TrainingEntry) UnmarshalJSON(data []byte) error {
+	var raw trainingEntryJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	duration, err := time.ParseDuration(raw.Duration)
+	if err != nil {
+		return fmt.Errorf("неверный формат длительности: %w", err)
+	}
+
+	*e = TrainingEntry{
+		Date:     raw.Date,
+		Steps:    raw.Steps,
+		Activity: raw.Activity,
+		Duration: duration,
+		Distance: raw.Distance,
+		Calories: raw.Calories,
+	}
+
+	return nil
+}
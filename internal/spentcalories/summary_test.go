@@ -0,0 +1,91 @@
+package spentcalories
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestNewTrainingEntry() {
+	date := time.Date(2025, time.January, 6, 8, 0, 0, 0, time.UTC)
+
+	got, err := NewTrainingEntry(date, "6000,Бег,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), date, got.Date)
+	assert.Equal(suite.T(), 6000, got.Steps)
+	assert.Equal(suite.T(), "Бег", got.Activity)
+	assert.Equal(suite.T(), time.Hour, got.Duration)
+	assert.InDelta(suite.T(), 4.725, got.Distance, 1e-9)
+	assert.InDelta(suite.T(), 354.375, got.Calories, 1e-9)
+
+	_, err = NewTrainingEntry(date, "6000,Плавание,1h00m", 75.0, 1.75)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *SpentCaloriesTestSuite) TestWeeklySummary() {
+	msk := time.FixedZone("MSK", 3*60*60)
+
+	entries := []TrainingEntry{
+		// Понедельник второй недели 2025 года по местному времени, но воскресенье по UTC
+		{Date: time.Date(2025, time.January, 6, 0, 30, 0, 0, msk), Distance: 3, Calories: 300, Duration: 30 * time.Minute},
+		{Date: time.Date(2024, time.December, 30, 10, 0, 0, 0, msk), Distance: 5, Calories: 400, Duration: time.Hour},
+		{Date: time.Date(2025, time.January, 5, 23, 0, 0, 0, msk), Distance: 2, Calories: 100, Duration: 20 * time.Minute},
+		{Date: time.Date(2024, time.December, 29, 9, 0, 0, 0, msk), Distance: 1, Calories: 50, Duration: 10 * time.Minute},
+	}
+
+	got := WeeklySummary(entries)
+
+	want := []PeriodSummary{
+		{Key: "2024-W52", Start: time.Date(2024, time.December, 23, 0, 0, 0, 0, msk), Distance: 1, Calories: 50, Duration: 10 * time.Minute},
+		{Key: "2025-W01", Start: time.Date(2024, time.December, 30, 0, 0, 0, 0, msk), Distance: 7, Calories: 500, Duration: 80 * time.Minute},
+		{Key: "2025-W02", Start: time.Date(2025, time.January, 6, 0, 0, 0, 0, msk), Distance: 3, Calories: 300, Duration: 30 * time.Minute},
+	}
+
+	assert.Equal(suite.T(), want, got)
+}
+
+func (suite *SpentCaloriesTestSuite) TestMonthlySummary() {
+	entries := []TrainingEntry{
+		{Date: time.Date(2025, time.January, 6, 8, 0, 0, 0, time.UTC), Distance: 3, Calories: 300, Duration: 30 * time.Minute},
+		{Date: time.Date(2024, time.December, 30, 8, 0, 0, 0, time.UTC), Distance: 5, Calories: 400, Duration: time.Hour},
+		{Date: time.Date(2024, time.December, 1, 8, 0, 0, 0, time.UTC), Distance: 1, Calories: 50, Duration: 10 * time.Minute},
+	}
+
+	got := MonthlySummary(entries)
+
+	want := []PeriodSummary{
+		{Key: "2024-12", Start: time.Date(2024, time.December, 1, 0, 0, 0, 0, time.UTC), Distance: 6, Calories: 450, Duration: 70 * time.Minute},
+		{Key: "2025-01", Start: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC), Distance: 3, Calories: 300, Duration: 30 * time.Minute},
+	}
+
+	assert.Equal(suite.T(), want, got)
+	assert.Empty(suite.T(), MonthlySummary(nil))
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingEntryJSON() {
+	entry := TrainingEntry{
+		Date:     time.Date(2025, time.January, 6, 8, 30, 15, 123, time.FixedZone("MSK", 3*60*60)),
+		Steps:    6000,
+		Activity: "Бег",
+		Duration: 90*time.Minute + 500*time.Millisecond,
+		Distance: 4.725,
+		Calories: 354.37500000000006,
+	}
+
+	data, err := json.Marshal(entry)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), string(data), `"duration":"1h30m0.5s"`)
+	assert.Contains(suite.T(), string(data), `"activity":"Бег"`)
+
+	var got TrainingEntry
+	assert.NoError(suite.T(), json.Unmarshal(data, &got))
+	assert.True(suite.T(), entry.Date.Equal(got.Date))
+	assert.Equal(suite.T(), entry.Steps, got.Steps)
+	assert.Equal(suite.T(), entry.Activity, got.Activity)
+	assert.Equal(suite.T(), entry.Duration, got.Duration)
+	assert.Equal(suite.T(), entry.Distance, got.Distance)
+	assert.Equal(suite.T(), entry.Calories, got.Calories)
+
+	assert.Error(suite.T(), json.Unmarshal([]byte(`{"duration":"soon"}`), &got))
+}
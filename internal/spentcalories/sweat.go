@@ -0,0 +1,31 @@
+package spentcalories
+
+import "math"
+
+// Параметры модели потоотделения.
+const (
+	heatFraction         = 0.8  // доля энергии тренировки, переходящая в тепло.
+	sweatLatentKcalPerL  = 580  // теплота испарения литра пота в ккал.
+	baselineSweatPerHour = 0.1  // потоотделение без нагрузки, л/ч.
+	sweatRefTemperature  = 20.0 // температура, при которой поправка равна 1, °C.
+	sweatTempCoefficient = 0.03 // изменение потоотделения на каждый градус.
+	minSweatTempFactor   = 0.4  // минимальная поправка на температуру в холоде.
+)
+
+// SweatLossLiters оценивает потерю жидкости с потом в литрах. Тепло, которое нужно
+// рассеять испарением, пропорционально сожжённым калориям, к нему добавляется
+// фоновое потоотделение за durationHours. Результат увеличивается на 3% на каждый
+// градус выше 20 °C и уменьшается ниже, но не более чем до 40%.
+func SweatLossLiters(calories float64, durationHours float64, tempCelsius float64) float64 {
+	if calories <= 0 || durationHours <= 0 {
+		return 0
+	}
+
+	// Пот, необходимый для рассеивания тепла от нагрузки, и фоновое потоотделение
+	sweat := calories*heatFraction/sweatLatentKcalPerL + baselineSweatPerHour*durationHours
+
+	// Поправка на температуру воздуха
+	tempFactor := math.Max(minSweatTempFactor, 1+sweatTempCoefficient*(tempCelsius-sweatRefTemperature))
+
+	return sweat * tempFactor
+}
@@ -0,0 +1,51 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestSweatLossLiters() {
+	tests := []struct {
+		name          string
+		calories      float64
+		durationHours float64
+		temp          float64
+		want          float64
+	}{
+		{
+			name:          "часовая пробежка в жару",
+			calories:      800,
+			durationHours: 1,
+			temp:          30,
+			want:          1.5645,
+		},
+		{
+			name:          "та же пробежка при 20 градусах",
+			calories:      800,
+			durationHours: 1,
+			temp:          20,
+			want:          1.2034,
+		},
+		{
+			name:          "мороз",
+			calories:      800,
+			durationHours: 1,
+			temp:          -20,
+			want:          0.4814,
+		},
+		{
+			name:          "нулевая длительность",
+			calories:      800,
+			durationHours: 0,
+			temp:          30,
+			want:          0,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got := SweatLossLiters(tt.calories, tt.durationHours, tt.temp)
+			assert.InDelta(suite.T(), tt.want, got, 0.001)
+		})
+	}
+}
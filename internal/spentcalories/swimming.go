@@ -0,0 +1,125 @@
+package spentcalories
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Коэффициенты для расчета калорий при плавании.
+const (
+	swimmingSpeedShift       = 1.1 // поправка к средней скорости плавания.
+	swimmingWeightMultiplier = 2.0 // множитель веса спортсмена.
+)
+
+// activitySwimming — каноническое название плавания.
+const activitySwimming = "плавание"
+
+// swimmingAliases — названия плавания. Плавание не входит в ActivityAliases,
+// потому что его запись содержит круги и длину бассейна вместо шагов.
+var swimmingAliases = map[string]bool{
+	"плавание": true,
+	"swimming": true,
+	"swim":     true,
+}
+
+func isSwimming(activity string) bool {
+	return swimmingAliases[normalizeActivity(activity)]
+}
+
+// swimmingDistance возвращает дистанцию в километрах за laps кругов в бассейне длиной poolLength метров.
+func swimmingDistance(laps int, poolLength float64) float64 {
+	return float64(laps) * poolLength / mInKm
+}
+
+// SwimmingSpentCalories рассчитывает калории для плавания по количеству кругов
+// и длине бассейна в метрах: калории = (скорость + 1.1) * 2 * вес * длительность в часах.
+func SwimmingSpentCalories(laps int, poolLength float64, weight float64, duration time.Duration) (float64, error) {
+	// Проверка входных параметров
+	if laps <= 0 {
+		return 0, fmt.Errorf("количество кругов должно быть больше 0")
+	}
+	if laps > maxSteps {
+		return 0, fmt.Errorf("количество кругов %d превышает допустимое значение %d", laps, maxSteps)
+	}
+	if poolLength <= 0 {
+		return 0, fmt.Errorf("длина бассейна должна быть больше 0")
+	}
+	if weight <= 0 {
+		return 0, fmt.Errorf("вес должен быть больше 0")
+	}
+	if duration <= 0 {
+		return 0, ErrNonPositiveDuration
+	}
+
+	hours := duration.Hours()
+	speed := swimmingDistance(laps, poolLength) / hours
+
+	return (speed + swimmingSpeedShift) * swimmingWeightMultiplier * weight * hours, nil
+}
+
+// parseSwimming разбирает запись плавания "круги,плавание,длительность,длина бассейна".
+// ok == false, если запись не относится к плаванию и должна разбираться parseTraining.
+func parseSwimming(data string) (laps int, activity string, duration time.Duration, poolLength float64, ok bool, err error) {
+	parts := strings.Split(data, ",")
+	if len(parts) != 4 || !isSwimming(parts[1]) {
+		return 0, "", 0, 0, false, nil
+	}
+
+	activity = strings.TrimSpace(parts[1])
+
+	// Парсим количество кругов
+	laps, err = strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return 0, "", 0, 0, true, newParseError(FieldLaps, parts[0], fmt.Errorf("неверный формат количества кругов: %w", err))
+	}
+	if laps <= 0 {
+		return 0, "", 0, 0, true, newParseError(FieldLaps, parts[0], fmt.Errorf("количество кругов должно быть больше 0"))
+	}
+
+	// Парсим длительность
+	duration, err = ParseDuration(strings.TrimSpace(parts[2]))
+	if err != nil {
+		return 0, "", 0, 0, true, newParseError(FieldDuration, parts[2], fmt.Errorf("неверный формат длительности: %w", err))
+	}
+	if err := checkDuration(duration, parts[2]); err != nil {
+		return 0, "", 0, 0, true, err
+	}
+
+	// Парсим длину бассейна в метрах
+	poolLength, err = strconv.ParseFloat(strings.TrimSpace(parts[3]), 64)
+	if err != nil {
+		return 0, "", 0, 0, true, newParseError(FieldPoolLength, parts[3], fmt.Errorf("неверный формат длины бассейна: %w", err))
+	}
+	if poolLength <= 0 {
+		return 0, "", 0, 0, true, newParseError(FieldPoolLength, parts[3], fmt.Errorf("длина бассейна должна быть больше 0"))
+	}
+
+	return laps, activity, duration, poolLength, true, nil
+}
+
+// swimmingTraining разбирает запись плавания и рассчитывает её показатели.
+// ok == false, если запись не относится к плаванию.
+func swimmingTraining(data string, weight float64) (t Training, ok bool, err error) {
+	laps, activity, duration, poolLength, ok, err := parseSwimming(data)
+	if !ok || err != nil {
+		return Training{}, ok, err
+	}
+
+	calories, err := SwimmingSpentCalories(laps, poolLength, weight, duration)
+	if err != nil {
+		return Training{}, true, err
+	}
+
+	dist := swimmingDistance(laps, poolLength)
+
+	return Training{
+		Activity: activity,
+		Steps:    laps,
+		Duration: duration,
+		Distance: dist,
+		Speed:    dist / duration.Hours(),
+		Calories: calories,
+	}, true, nil
+}
@@ -0,0 +1,163 @@
+package spentcalories
+
+import (
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestSwimmingSpentCalories() {
+	tests := []struct {
+		name       string
+		laps       int
+		poolLength float64
+		weight     float64
+		duration   time.Duration
+		want       float64
+		wantErr    bool
+	}{
+		{
+			name:       "1 км за час в 25-метровом бассейне",
+			laps:       40,
+			poolLength: 25,
+			weight:     75.0,
+			duration:   time.Hour,
+			want:       (1 + 1.1) * 2 * 75,
+			wantErr:    false,
+		},
+		{
+			name:       "1.5 км за полчаса в 50-метровом бассейне",
+			laps:       30,
+			poolLength: 50,
+			weight:     60.0,
+			duration:   30 * time.Minute,
+			want:       (3 + 1.1) * 2 * 60 * 0.5,
+			wantErr:    false,
+		},
+		{
+			name:       "нулевое количество кругов",
+			laps:       0,
+			poolLength: 25,
+			weight:     75.0,
+			duration:   time.Hour,
+			wantErr:    true,
+		},
+		{
+			name:       "нулевая длина бассейна",
+			laps:       40,
+			poolLength: 0,
+			weight:     75.0,
+			duration:   time.Hour,
+			wantErr:    true,
+		},
+		{
+			name:       "нулевой вес",
+			laps:       40,
+			poolLength: 25,
+			weight:     0,
+			duration:   time.Hour,
+			wantErr:    true,
+		},
+		{
+			name:       "нулевая длительность",
+			laps:       40,
+			poolLength: 25,
+			weight:     75.0,
+			duration:   0,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := SwimmingSpentCalories(tt.laps, tt.poolLength, tt.weight, tt.duration)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoSwimming() {
+	tests := []struct {
+		name      string
+		input     string
+		want      string
+		wantField string
+		wantErr   string
+	}{
+		{
+			name:  "плавание",
+			input: "40,Плавание,1h00m,25",
+			want: "Тип тренировки: Плавание\n" +
+				"Длительность: 1.00 ч.\n" +
+				"Дистанция: 1.00 км.\n" +
+				"Скорость: 1.00 км/ч\n" +
+				"Сожгли калорий: 315.00\n",
+		},
+		{
+			name:  "английское название",
+			input: "40, swimming ,1h00m, 25",
+			want: "Тип тренировки: swimming\n" +
+				"Длительность: 1.00 ч.\n" +
+				"Дистанция: 1.00 км.\n" +
+				"Скорость: 1.00 км/ч\n" +
+				"Сожгли калорий: 315.00\n",
+		},
+		{
+			name:    "плавание без длины бассейна",
+			input:   "40,Плавание,1h00m",
+			wantErr: "неизвестный тип тренировки",
+		},
+		{
+			name:      "некорректное количество кругов",
+			input:     "abc,Плавание,1h00m,25",
+			wantField: FieldLaps,
+		},
+		{
+			name:      "некорректная длина бассейна",
+			input:     "40,Плавание,1h00m,-25",
+			wantField: FieldPoolLength,
+		},
+		{
+			name:      "некорректная длительность",
+			input:     "40,Плавание,0h00m,25",
+			wantField: FieldDuration,
+		},
+		{
+			name:    "четыре поля для бега",
+			input:   "6000,Бег,1h00m,25",
+			wantErr: "неверный формат данных",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := TrainingInfo(tt.input, 75.0, 1.75)
+
+			if tt.wantField != "" {
+				var parseErr *ParseError
+				assert.True(suite.T(), errors.As(err, &parseErr))
+				assert.Equal(suite.T(), tt.wantField, parseErr.Field)
+				return
+			}
+			if tt.wantErr != "" {
+				assert.ErrorContains(suite.T(), err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+
+			training, err := NewTraining(tt.input, 75.0, 1.75)
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), 40, training.Steps)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package spentcalories
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Training — тренировка с рассчитанными показателями.
+type Training struct {
+	Activity string        // вид активности.
+	Steps    int           // количество шагов; обороты педалей для велосипеда, круги для плавания.
+	Duration time.Duration // продолжительность тренировки.
+	Distance float64       // дистанция в километрах.
+	Speed    float64       // средняя скорость в км/ч.
+	Calories float64       // количество сожжённых калорий.
+}
+
+// TrainingResult — результат расчета тренировки. Синоним Training.
+type TrainingResult = Training
+
+// TrainingSummary — структурированный результат TrainingInfo. Синоним Training.
+type TrainingSummary = Training
+
+// NewTraining разбирает строку тренировки и рассчитывает её показатели.
+func NewTraining(data string, weight, height float64) (Training, error) {
+	return NewTrainingWithStepLength(data, weight, height, 0)
+}
+
+// NewTrainingWithStepLength работает как NewTraining, но рассчитывает дистанцию, скорость
+// и калории бега и ходьбы по измеренной длине шага stepLength в метрах вместо роста.
+// Длина шага должна быть больше 0 и не больше 2 м; 0 означает расчет по росту.
+func NewTrainingWithStepLength(data string, weight, height, stepLength float64) (Training, error) {
+	// Запись плавания содержит круги и длину бассейна вместо шагов
+	if t, ok, err := swimmingTraining(data, weight); ok {
+		return t, err
+	}
+
+	steps, activity, duration, err := parseTraining(data)
+	if err != nil {
+		return Training{}, err
+	}
+
+	return trainingResult(activity, steps, duration, weight, height, stepLength)
+}
+
+// CalculateTraining рассчитывает показатели тренировки по уже разобранным полям
+// и проверяет их по тем же правилам, что и строковый формат.
+func CalculateTraining(steps int, activity string, duration time.Duration, weight, height float64) (Training, error) {
+	return CalculateTrainingWithStepLength(steps, activity, duration, weight, height, 0)
+}
+
+// CalculateTrainingWithStepLength работает как CalculateTraining, но учитывает измеренную
+// длину шага в метрах так же, как NewTrainingWithStepLength.
+func CalculateTrainingWithStepLength(steps int, activity string, duration time.Duration, weight, height, stepLength float64) (Training, error) {
+	if err := checkSteps(steps, strconv.Itoa(steps)); err != nil {
+		return Training{}, err
+	}
+	if err := checkActivity(strings.TrimSpace(activity), activity); err != nil {
+		return Training{}, err
+	}
+	if err := checkDuration(duration, duration.String()); err != nil {
+		return Training{}, err
+	}
+
+	return trainingResult(strings.TrimSpace(activity), steps, duration, weight, height, stepLength)
+}
+
+// String форматирует результат тренировки так же, как TrainingInfo.
+func (t Training) String() string {
+	return t.Format(DefaultFormatOptions())
+}
+
+// IsPersonalBest сравнивает тренировку с историей и сообщает, в каких показателях
+// она превосходит все предыдущие. При пустой истории все показатели считаются рекордными.
+func IsPersonalBest(t Training, history []Training) (bestDistance, bestSpeed, bestCalories bool) {
+	bestDistance, bestSpeed, bestCalories = true, true, true
+
+	for _, h := range history {
+		if h.Distance >= t.Distance {
+			bestDistance = false
+		}
+		if h.Speed >= t.Speed {
+			bestSpeed = false
+		}
+		if h.Calories >= t.Calories {
+			bestCalories = false
+		}
+	}
+
+	return bestDistance, bestSpeed, bestCalories
+}
+
+// AverageSpeed возвращает среднюю скорость в км/ч по нескольким тренировкам:
+// суммарная дистанция делится на суммарное время. Для пустого набора возвращается 0.
+func AverageSpeed(results []TrainingResult) float64 {
+	distanceKm, duration := totalDistanceAndDuration(results)
+
+	hours := duration.Hours()
+	if hours <= 0 {
+		return 0
+	}
+
+	return distanceKm / hours
+}
+
+// AveragePace возвращает средний темп в минутах на километр по нескольким тренировкам:
+// суммарное время делится на суммарную дистанцию. Для пустого набора возвращается 0.
+func AveragePace(results []TrainingResult) float64 {
+	distanceKm, duration := totalDistanceAndDuration(results)
+
+	if distanceKm <= 0 {
+		return 0
+	}
+
+	return duration.Minutes() / distanceKm
+}
+
+func totalDistanceAndDuration(results []TrainingResult) (float64, time.Duration) {
+	var (
+		distanceKm float64
+		duration   time.Duration
+	)
+
+	for _, r := range results {
+		distanceKm += r.Distance
+		duration += r.Duration
+	}
+
+	return distanceKm, duration
+}
@@ -0,0 +1,197 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestNewTraining() {
+	got, err := NewTraining("6000,Ходьба,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Ходьба", got.Activity)
+	assert.Equal(suite.T(), 6000, got.Steps)
+	assert.Equal(suite.T(), time.Hour, got.Duration)
+	assert.InDelta(suite.T(), 4.725, got.Distance, 1e-9)
+	assert.InDelta(suite.T(), 4.725, got.Speed, 1e-9)
+	assert.InDelta(suite.T(), 177.1875, got.Calories, 1e-9)
+
+	_, err = NewTraining("6000,Ходьба", 75.0, 1.75)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *SpentCaloriesTestSuite) TestCalculateTraining() {
+	got, err := CalculateTraining(6000, " Бег ", time.Hour, 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Бег", got.Activity)
+	assert.InDelta(suite.T(), 354.375, got.Calories, 1e-9)
+
+	_, err = CalculateTraining(0, "Бег", time.Hour, 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveSteps)
+
+	_, err = CalculateTraining(6000, " ", time.Hour, 75.0, 1.75)
+	assert.ErrorContains(suite.T(), err, "вид активности не может быть пустым")
+
+	_, err = CalculateTraining(6000, "Бег", 0, 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveDuration)
+
+	_, err = CalculateTraining(6000, "Плавание", time.Hour, 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, ErrUnknownActivity)
+}
+
+func (suite *SpentCaloriesTestSuite) TestStepsForDistance() {
+	steps, err := StepsForDistance("Бег", 4.725, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 6000, steps)
+
+	steps, err = StepsForDistance("Велосипед", 9, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1500, steps)
+
+	steps, err = StepsForDistance("Ходьба", 0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 0, steps)
+
+	_, err = StepsForDistance("Ходьба", 3.9, 175)
+	assert.ErrorContains(suite.T(), err, "сантиметрах")
+
+	// Дистанция по найденным шагам совпадает с исходной
+	steps, err = StepsForDistance("Ходьба", 3.9, 1.75)
+	assert.NoError(suite.T(), err)
+	got, err := NewTraining(fmt.Sprintf("%d,Ходьба,1h", steps), 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 3.9, got.Distance, 1e-3)
+}
+
+func (suite *SpentCaloriesTestSuite) TestIsPersonalBest() {
+	history := []Training{
+		{Distance: 10, Speed: 9, Calories: 600},
+		{Distance: 5, Speed: 12, Calories: 400},
+	}
+
+	tests := []struct {
+		name         string
+		training     Training
+		history      []Training
+		wantDistance bool
+		wantSpeed    bool
+		wantCalories bool
+	}{
+		{
+			name:         "пустая история",
+			training:     Training{Distance: 1, Speed: 1, Calories: 1},
+			history:      nil,
+			wantDistance: true,
+			wantSpeed:    true,
+			wantCalories: true,
+		},
+		{
+			name:         "рекорд дистанции и калорий",
+			training:     Training{Distance: 12, Speed: 10, Calories: 700},
+			history:      history,
+			wantDistance: true,
+			wantSpeed:    false,
+			wantCalories: true,
+		},
+		{
+			name:         "рекорд скорости",
+			training:     Training{Distance: 3, Speed: 13, Calories: 250},
+			history:      history,
+			wantDistance: false,
+			wantSpeed:    true,
+			wantCalories: false,
+		},
+		{
+			name:         "повторение рекорда не считается новым",
+			training:     Training{Distance: 10, Speed: 12, Calories: 600},
+			history:      history,
+			wantDistance: false,
+			wantSpeed:    false,
+			wantCalories: false,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			gotDistance, gotSpeed, gotCalories := IsPersonalBest(tt.training, tt.history)
+			assert.Equal(suite.T(), tt.wantDistance, gotDistance)
+			assert.Equal(suite.T(), tt.wantSpeed, gotSpeed)
+			assert.Equal(suite.T(), tt.wantCalories, gotCalories)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestAverageSpeedAndPace() {
+	results := []TrainingResult{
+		{Distance: 10, Duration: 1 * time.Hour, Speed: 10},
+		{Distance: 5, Duration: 20 * time.Minute, Speed: 15},
+		{Distance: 3, Duration: 40 * time.Minute, Speed: 4.5},
+	}
+
+	// 18 км за 2 часа, а не среднее скоростей (9.83 км/ч)
+	assert.InDelta(suite.T(), 9.0, AverageSpeed(results), 1e-9)
+	assert.InDelta(suite.T(), 120.0/18.0, AveragePace(results), 1e-9)
+
+	assert.Equal(suite.T(), 0.0, AverageSpeed(nil))
+	assert.Equal(suite.T(), 0.0, AveragePace(nil))
+	assert.Equal(suite.T(), 0.0, AveragePace([]TrainingResult{{Duration: time.Hour}}))
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingResultString() {
+	// Вывод TrainingInfo до появления TrainingResult для веса 75 кг и роста 1.75 м
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{
+			input: "6000,Ходьба,1h00m",
+			want:  "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\nСкорость: 4.72 км/ч\nСожгли калорий: 177.19\n",
+		},
+		{
+			input: "6000,Бег,1h00m",
+			want:  "Тип тренировки: Бег\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\nСкорость: 4.72 км/ч\nСожгли калорий: 354.38\n",
+		},
+		{
+			input: "3000,Бег,30m",
+			want:  "Тип тренировки: Бег\nДлительность: 0.50 ч.\nДистанция: 2.36 км.\nСкорость: 4.72 км/ч\nСожгли калорий: 177.19\n",
+		},
+		{
+			input: "20000,Бег,1h00m",
+			want:  "Тип тренировки: Бег\nДлительность: 1.00 ч.\nДистанция: 15.75 км.\nСкорость: 15.75 км/ч\nСожгли калорий: 1181.25\n",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.input, func() {
+			result, err := NewTraining(tt.input, 75.0, 1.75)
+			assert.NoError(suite.T(), err)
+
+			assert.Equal(suite.T(), tt.want, result.String())
+			assert.Equal(suite.T(), tt.want, fmt.Sprint(result))
+
+			info, err := TrainingInfo(tt.input, 75.0, 1.75)
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, info)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoStruct() {
+	got, err := TrainingInfoStruct("6000,Бег,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Бег", got.Activity)
+	assert.Equal(suite.T(), time.Hour, got.Duration)
+	assert.InDelta(suite.T(), 4.725, got.Distance, 1e-9)
+	assert.InDelta(suite.T(), 4.725, got.Speed, 1e-9)
+	assert.InDelta(suite.T(), 354.375, got.Calories, 1e-9)
+
+	// Строковый отчёт строится из той же структуры
+	info, err := TrainingInfo("6000,Бег,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), info, got.String())
+
+	got, err = TrainingInfoStruct("6000,Бег", 75.0, 1.75)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), TrainingSummary{}, got)
+}
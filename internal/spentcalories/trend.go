@@ -0,0 +1,41 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+)
+
+// EWMACalories сглаживает ряд калорий по дням экспоненциальным скользящим средним:
+// s[0] = x[0], s[i] = alpha*x[i] + (1-alpha)*s[i-1]. Значение alpha должно быть в (0, 1].
+func EWMACalories(dailyCalories []float64, alpha float64) ([]float64, error) {
+	if alpha <= 0 || alpha > 1 {
+		return nil, fmt.Errorf("коэффициент сглаживания должен быть в диапазоне (0, 1]")
+	}
+
+	result := make([]float64, len(dailyCalories))
+
+	for i, calories := range dailyCalories {
+		// Первое значение ряда берём без сглаживания
+		if i == 0 {
+			result[i] = calories
+			continue
+		}
+
+		result[i] = alpha*calories + (1-alpha)*result[i-1]
+	}
+
+	return result, nil
+}
+
+// ProjectDailyCalories прогнозирует калории за весь день, продолжая текущий темп:
+// к уже сожжённым калориям добавляется расход за оставшееся время с той же скоростью.
+// Если прошедшее время не больше 0, темп неизвестен и возвращаются текущие калории.
+func ProjectDailyCalories(currentCalories float64, elapsed, remaining time.Duration) float64 {
+	if elapsed <= 0 || remaining <= 0 {
+		return currentCalories
+	}
+
+	rate := currentCalories / elapsed.Hours()
+
+	return currentCalories + rate*remaining.Hours()
+}
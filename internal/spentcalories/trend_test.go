@@ -0,0 +1,112 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestEWMACalories() {
+	tests := []struct {
+		name    string
+		daily   []float64
+		alpha   float64
+		want    []float64
+		wantErr bool
+	}{
+		{
+			name:    "ряд из четырёх дней",
+			daily:   []float64{100, 200, 300, 100},
+			alpha:   0.5,
+			want:    []float64{100, 150, 225, 162.5},
+			wantErr: false,
+		},
+		{
+			name:    "без сглаживания",
+			daily:   []float64{100, 200},
+			alpha:   1,
+			want:    []float64{100, 200},
+			wantErr: false,
+		},
+		{
+			name:    "пустой ряд",
+			daily:   nil,
+			alpha:   0.3,
+			want:    []float64{},
+			wantErr: false,
+		},
+		{
+			name:    "нулевой коэффициент",
+			daily:   []float64{100},
+			alpha:   0,
+			wantErr: true,
+		},
+		{
+			name:    "коэффициент больше единицы",
+			daily:   []float64{100},
+			alpha:   1.5,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := EWMACalories(tt.daily, tt.alpha)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Nil(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDeltaSlice(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestProjectDailyCalories() {
+	tests := []struct {
+		name      string
+		current   float64
+		elapsed   time.Duration
+		remaining time.Duration
+		want      float64
+	}{
+		{
+			name:      "половина дня прошла",
+			current:   900,
+			elapsed:   12 * time.Hour,
+			remaining: 12 * time.Hour,
+			want:      1800,
+		},
+		{
+			name:      "прошла четверть дня",
+			current:   300,
+			elapsed:   6 * time.Hour,
+			remaining: 18 * time.Hour,
+			want:      1200,
+		},
+		{
+			name:      "день только начался",
+			current:   0,
+			elapsed:   0,
+			remaining: 24 * time.Hour,
+			want:      0,
+		},
+		{
+			name:      "день закончился",
+			current:   2100,
+			elapsed:   24 * time.Hour,
+			remaining: 0,
+			want:      2100,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got := ProjectDailyCalories(tt.current, tt.elapsed, tt.remaining)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package spentcalories
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Yandex-Practicum/tracker/internal/units"
+)
+
+// Метки единиц измерения в строке данных MixedUnitsCalories.
+const (
+	metricUnitsMarker   = "kg/m"  // метка метрических единиц в строке данных.
+	imperialUnitsMarker = "lb/in" // метка имперских единиц в строке данных.
+)
+
+func parseMixedUnitsLine(line string) (string, float64, float64, error) {
+	parts := strings.Split(line, ",")
+
+	// Метка единиц измерения необязательна, по умолчанию используются метрические единицы
+	marker := metricUnitsMarker
+	if len(parts) == 6 {
+		marker = strings.ToLower(strings.TrimSpace(parts[5]))
+		parts = parts[:5]
+	}
+
+	if len(parts) != 5 {
+		return "", 0, 0, fmt.Errorf("%w, ожидается 'шаги,активность,длительность,вес,рост[,единицы]'", ErrInvalidFormat)
+	}
+
+	weight, err := strconv.ParseFloat(strings.TrimSpace(parts[3]), 64)
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("неверный формат веса: %v", err)
+	}
+
+	height, err := strconv.ParseFloat(strings.TrimSpace(parts[4]), 64)
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("неверный формат роста: %v", err)
+	}
+
+	// Переводим вес и рост в килограммы и метры
+	switch marker {
+	case metricUnitsMarker:
+	case imperialUnitsMarker:
+		weight = units.Imperial.WeightToKg(weight)
+		height = units.Imperial.HeightToMeters(height)
+	default:
+		return "", 0, 0, fmt.Errorf("неизвестные единицы измерения: %s", marker)
+	}
+
+	return strings.Join(parts[:3], ","), weight, height, nil
+}
+
+// MixedUnitsCalories рассчитывает калории для набора строк вида
+// "шаги,активность,длительность,вес,рост[,единицы]", где единицы — "kg/m" (по умолчанию) или "lb/in".
+func MixedUnitsCalories(lines []string) ([]float64, error) {
+	result := make([]float64, 0, len(lines))
+
+	for i, line := range lines {
+		// Отделяем данные тренировки от веса и роста
+		data, weight, height, err := parseMixedUnitsLine(line)
+		if err != nil {
+			return nil, withLine(err, i+1)
+		}
+
+		steps, activity, duration, err := parseTraining(data)
+		if err != nil {
+			return nil, withLine(err, i+1)
+		}
+
+		calories, err := spentCalories(activity, steps, weight, height, duration)
+		if err != nil {
+			return nil, withLine(err, i+1)
+		}
+
+		result = append(result, calories)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,71 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestMixedUnitsCalories() {
+	tests := []struct {
+		name    string
+		lines   []string
+		wantCal []float64
+		wantErr bool
+	}{
+		{
+			name: "метрическая и имперская строки",
+			lines: []string{
+				"6000,Ходьба,1h00m,75,1.75",
+				"6000,Ходьба,1h00m,165.3467,68.8976,lb/in",
+			},
+			wantCal: []float64{177.19, 177.19},
+			wantErr: false,
+		},
+		{
+			name: "явная метка метрических единиц",
+			lines: []string{
+				"6000,Бег,1h00m,75,1.75,kg/m",
+			},
+			wantCal: []float64{354.375},
+			wantErr: false,
+		},
+		{
+			name: "неизвестные единицы",
+			lines: []string{
+				"6000,Бег,1h00m,75,1.75,st/ft",
+			},
+			wantErr: true,
+		},
+		{
+			name: "нет веса и роста",
+			lines: []string{
+				"6000,Бег,1h00m",
+			},
+			wantErr: true,
+		},
+		{
+			name: "некорректный вес",
+			lines: []string{
+				"6000,Бег,1h00m,abc,1.75",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := MixedUnitsCalories(tt.lines)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Nil(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Len(suite.T(), got, len(tt.wantCal))
+			for i := range tt.wantCal {
+				assert.InDelta(suite.T(), tt.wantCal[i], got[i], 0.01)
+			}
+		})
+	}
+}
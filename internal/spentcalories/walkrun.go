@@ -0,0 +1,25 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+)
+
+// WalkRunCalories рассчитывает калории для тренировки по методу Гэллоуэя,
+// в которой чередуются фазы бега и ходьбы. Шаги и длительность каждой фазы
+// указываются суммарно за всю тренировку.
+func WalkRunCalories(runSteps, walkSteps int, weight, height float64, runDur, walkDur time.Duration) (float64, error) {
+	// Рассчитываем калории беговой фазы
+	run, err := RunningSpentCalories(runSteps, weight, height, runDur)
+	if err != nil {
+		return 0, fmt.Errorf("фаза бега: %w", err)
+	}
+
+	// Рассчитываем калории фазы ходьбы
+	walk, err := WalkingSpentCalories(walkSteps, weight, height, walkDur)
+	if err != nil {
+		return 0, fmt.Errorf("фаза ходьбы: %w", err)
+	}
+
+	return run + walk, nil
+}
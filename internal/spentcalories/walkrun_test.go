@@ -0,0 +1,59 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestWalkRunCalories() {
+	tests := []struct {
+		name      string
+		runSteps  int
+		walkSteps int
+		runDur    time.Duration
+		walkDur   time.Duration
+		want      float64
+		wantErr   bool
+	}{
+		{
+			name:      "30 минут: 4 минуты бега и 2 минуты ходьбы пять раз",
+			runSteps:  3000,
+			walkSteps: 1000,
+			runDur:    20 * time.Minute,
+			walkDur:   10 * time.Minute,
+			want:      206.71875,
+			wantErr:   false,
+		},
+		{
+			name:      "нет шагов ходьбы",
+			runSteps:  3000,
+			walkSteps: 0,
+			runDur:    20 * time.Minute,
+			walkDur:   10 * time.Minute,
+			wantErr:   true,
+		},
+		{
+			name:      "нулевая длительность бега",
+			runSteps:  3000,
+			walkSteps: 1000,
+			runDur:    0,
+			walkDur:   10 * time.Minute,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := WalkRunCalories(tt.runSteps, tt.walkSteps, 75.0, 1.75, tt.runDur, tt.walkDur)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Yandex-Practicum/tracker/internal/daysteps"
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+// fileData — содержимое JSON-файла хранилища.
+type fileData struct {
+	Trainings []spentcalories.TrainingEntry `json:"trainings"`
+	Days      []daysteps.DayEntry           `json:"days"`
+}
+
+// FileStore хранит записи в JSON-файле. Файл целиком читается при открытии
+// и перезаписывается при каждом сохранении. Методы безопасно вызывать из нескольких горутин.
+type FileStore struct {
+	mu   sync.RWMutex
+	path string
+	data fileData
+}
+
+var _ Store = (*FileStore)(nil)
+
+// OpenFile открывает хранилище в JSON-файле path. Если файла нет, он будет создан
+// при первом сохранении.
+func OpenFile(path string) (*FileStore, error) {
+	s := &FileStore{path: path}
+
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("не удалось прочитать файл хранилища: %w", err)
+	}
+
+	if err := json.Unmarshal(raw, &s.data); err != nil {
+		return nil, fmt.Errorf("неверный формат файла хранилища: %w", err)
+	}
+
+	return s, nil
+}
+
+// SaveTraining сохраняет тренировку и записывает файл.
+func (s *FileStore) SaveTraining(entry spentcalories.TrainingEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.data.Trainings = append(s.data.Trainings, entry)
+	if err := s.flush(); err != nil {
+		s.data.Trainings = s.data.Trainings[:len(s.data.Trainings)-1]
+		return err
+	}
+
+	return nil
+}
+
+// SaveDayPackage сохраняет запись дневной активности и записывает файл.
+func (s *FileStore) SaveDayPackage(entry daysteps.DayEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.data.Days = append(s.data.Days, entry)
+	if err := s.flush(); err != nil {
+		s.data.Days = s.data.Days[:len(s.data.Days)-1]
+		return err
+	}
+
+	return nil
+}
+
+// ListByDate возвращает записи с датой в интервале [from, to), упорядоченные по дате.
+func (s *FileStore) ListByDate(from, to time.Time) (Records, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	inPeriod := func(date time.Time) bool {
+		return !date.Before(from) && date.Before(to)
+	}
+
+	var records Records
+	for _, e := range s.data.Trainings {
+		if inPeriod(e.Date) {
+			records.Trainings = append(records.Trainings, e)
+		}
+	}
+	for _, e := range s.data.Days {
+		if inPeriod(e.Date) {
+			records.Days = append(records.Days, e)
+		}
+	}
+
+	sortTrainings(records.Trainings)
+	sort.SliceStable(records.Days, func(i, j int) bool {
+		return records.Days[i].Date.Before(records.Days[j].Date)
+	})
+
+	return records, nil
+}
+
+// ListByActivity возвращает тренировки с заданным видом активности, упорядоченные по дате.
+func (s *FileStore) ListByActivity(activity string) ([]spentcalories.TrainingEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	activity = strings.TrimSpace(activity)
+
+	var result []spentcalories.TrainingEntry
+	for _, e := range s.data.Trainings {
+		if strings.EqualFold(strings.TrimSpace(e.Activity), activity) {
+			result = append(result, e)
+		}
+	}
+
+	sortTrainings(result)
+	return result, nil
+}
+
+// Close ничего не делает: данные записываются в файл при каждом сохранении.
+func (s *FileStore) Close() error {
+	return nil
+}
+
+// flush атомарно перезаписывает файл: данные пишутся во временный файл,
+// который затем переименовывается, чтобы сбой не оставил файл наполовину записанным.
+func (s *FileStore) flush() error {
+	raw, err := json.MarshalIndent(s.data, "", "  ")
+	if err != nil {
+		return fmt.Errorf("не удалось сохранить записи: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("не удалось создать временный файл: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(raw); err != nil {
+		tmp.Close()
+		return fmt.Errorf("не удалось записать файл хранилища: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("не удалось записать файл хранилища: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("не удалось заменить файл хранилища: %w", err)
+	}
+
+	return nil
+}
+
+func sortTrainings(entries []spentcalories.TrainingEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Date.Before(entries[j].Date)
+	})
+}
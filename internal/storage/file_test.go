@@ -0,0 +1,91 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Yandex-Practicum/tracker/internal/daysteps"
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+type StorageTestSuite struct {
+	suite.Suite
+}
+
+func TestStorageSuite(t *testing.T) {
+	suite.Run(t, new(StorageTestSuite))
+}
+
+func day(d, hour int) time.Time {
+	return time.Date(2025, time.January, d, hour, 0, 0, 0, time.UTC)
+}
+
+func (suite *StorageTestSuite) TestFileStoreRoundTrip() {
+	path := filepath.Join(suite.T().TempDir(), "tracker.json")
+
+	store, err := OpenFile(path)
+	assert.NoError(suite.T(), err)
+
+	run, err := spentcalories.NewTrainingEntry(day(14, 18), "6000,Бег,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	walk, err := spentcalories.NewTrainingEntry(day(13, 18), "3456,Ходьба,3h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	assert.NoError(suite.T(), store.SaveTraining(run))
+	assert.NoError(suite.T(), store.SaveTraining(walk))
+	assert.NoError(suite.T(), store.SaveDayPackage(daysteps.DayEntry{Date: day(13, 8), Steps: 6000, Duration: time.Hour}))
+	assert.NoError(suite.T(), store.SaveDayPackage(daysteps.DayEntry{Date: day(20, 8), Steps: 3000, Duration: 30 * time.Minute}))
+	assert.NoError(suite.T(), store.Close())
+
+	// Данные доступны после повторного открытия
+	reopened, err := OpenFile(path)
+	assert.NoError(suite.T(), err)
+
+	records, err := reopened.ListByDate(day(13, 0), day(15, 0))
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), records.Trainings, 2)
+	assert.Equal(suite.T(), "Ходьба", records.Trainings[0].Activity)
+	assert.Equal(suite.T(), "Бег", records.Trainings[1].Activity)
+	assert.Equal(suite.T(), run.Calories, records.Trainings[1].Calories)
+	assert.Len(suite.T(), records.Days, 1)
+	assert.Equal(suite.T(), 6000, records.Days[0].Steps)
+	assert.Equal(suite.T(), time.Hour, records.Days[0].Duration)
+
+	runs, err := reopened.ListByActivity(" бег ")
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), runs, 1)
+	assert.True(suite.T(), run.Date.Equal(runs[0].Date))
+
+	none, err := reopened.ListByActivity("Велосипед")
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), none)
+
+	// Временные файлы не остаются рядом с хранилищем
+	files, err := os.ReadDir(filepath.Dir(path))
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), files, 1)
+}
+
+func (suite *StorageTestSuite) TestOpenFileErrors() {
+	dir := suite.T().TempDir()
+
+	broken := filepath.Join(dir, "broken.json")
+	assert.NoError(suite.T(), os.WriteFile(broken, []byte("{"), 0o600))
+
+	_, err := OpenFile(broken)
+	assert.ErrorContains(suite.T(), err, "неверный формат файла хранилища")
+
+	// Сохранение в несуществующий каталог возвращает ошибку и не меняет данные
+	store, err := OpenFile(filepath.Join(dir, "missing", "tracker.json"))
+	assert.NoError(suite.T(), err)
+	assert.Error(suite.T(), store.SaveDayPackage(daysteps.DayEntry{Date: day(13, 8), Steps: 6000, Duration: time.Hour}))
+
+	records, err := store.ListByDate(day(1, 0), day(31, 0))
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), records.Days)
+}
@@ -0,0 +1,221 @@
+// Package sqlite реализует storage.Store поверх базы данных SQLite.
+//
+// Пакет использует только database/sql и не импортирует драйвер: вызывающий код
+// подключает драйвер SQLite, например modernc.org/sqlite или github.com/mattn/go-sqlite3,
+// и передаёт открытую базу в New.
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Yandex-Practicum/tracker/internal/daysteps"
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+	"github.com/Yandex-Practicum/tracker/internal/storage"
+)
+
+// schema создаёт таблицы и индексы для запросов по дате и виду активности.
+// Дата хранится дважды: в наносекундах для индекса и сортировки и строкой RFC 3339
+// для восстановления часового пояса записи.
+var schema = []string{
+	`CREATE TABLE IF NOT EXISTS trainings (
+		id           INTEGER PRIMARY KEY AUTOINCREMENT,
+		date_ns      INTEGER NOT NULL,
+		date         TEXT    NOT NULL,
+		steps        INTEGER NOT NULL,
+		activity     TEXT    NOT NULL,
+		activity_key TEXT    NOT NULL,
+		duration_ns  INTEGER NOT NULL,
+		distance     REAL    NOT NULL,
+		calories     REAL    NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS trainings_date ON trainings (date_ns)`,
+	`CREATE INDEX IF NOT EXISTS trainings_activity_date ON trainings (activity_key, date_ns)`,
+	`CREATE TABLE IF NOT EXISTS day_packages (
+		id          INTEGER PRIMARY KEY AUTOINCREMENT,
+		date_ns     INTEGER NOT NULL,
+		date        TEXT    NOT NULL,
+		steps       INTEGER NOT NULL,
+		duration_ns INTEGER NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS day_packages_date ON day_packages (date_ns)`,
+}
+
+// Store хранит записи в базе данных SQLite.
+type Store struct {
+	db *sql.DB
+}
+
+var _ storage.Store = (*Store)(nil)
+
+// New создаёт таблицы и индексы, если их ещё нет, и возвращает хранилище.
+// Store становится владельцем db и закрывает её в Close.
+func New(db *sql.DB) (*Store, error) {
+	for _, stmt := range schema {
+		if _, err := db.Exec(stmt); err != nil {
+			return nil, fmt.Errorf("не удалось создать схему базы данных: %w", err)
+		}
+	}
+
+	return &Store{db: db}, nil
+}
+
+// SaveTraining сохраняет тренировку.
+func (s *Store) SaveTraining(entry spentcalories.TrainingEntry) error {
+	_, err := s.db.Exec(
+		`INSERT INTO trainings (date_ns, date, steps, activity, activity_key, duration_ns, distance, calories)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		entry.Date.UnixNano(),
+		formatDate(entry.Date),
+		entry.Steps,
+		entry.Activity,
+		activityKey(entry.Activity),
+		int64(entry.Duration),
+		entry.Distance,
+		entry.Calories,
+	)
+	if err != nil {
+		return fmt.Errorf("не удалось сохранить тренировку: %w", err)
+	}
+
+	return nil
+}
+
+// SaveDayPackage сохраняет запись дневной активности.
+func (s *Store) SaveDayPackage(entry daysteps.DayEntry) error {
+	_, err := s.db.Exec(
+		`INSERT INTO day_packages (date_ns, date, steps, duration_ns) VALUES (?, ?, ?, ?)`,
+		entry.Date.UnixNano(),
+		formatDate(entry.Date),
+		entry.Steps,
+		int64(entry.Duration),
+	)
+	if err != nil {
+		return fmt.Errorf("не удалось сохранить дневную активность: %w", err)
+	}
+
+	return nil
+}
+
+// ListByDate возвращает записи с датой в интервале [from, to), упорядоченные по дате.
+func (s *Store) ListByDate(from, to time.Time) (storage.Records, error) {
+	trainings, err := s.queryTrainings(
+		`SELECT date, steps, activity, duration_ns, distance, calories FROM trainings
+		WHERE date_ns >= ? AND date_ns < ? ORDER BY date_ns, id`,
+		from.UnixNano(), to.UnixNano(),
+	)
+	if err != nil {
+		return storage.Records{}, err
+	}
+
+	days, err := s.queryDays(from, to)
+	if err != nil {
+		return storage.Records{}, err
+	}
+
+	return storage.Records{Trainings: trainings, Days: days}, nil
+}
+
+// ListByActivity возвращает тренировки с заданным видом активности, упорядоченные по дате.
+func (s *Store) ListByActivity(activity string) ([]spentcalories.TrainingEntry, error) {
+	return s.queryTrainings(
+		`SELECT date, steps, activity, duration_ns, distance, calories FROM trainings
+		WHERE activity_key = ? ORDER BY date_ns, id`,
+		activityKey(activity),
+	)
+}
+
+// Close закрывает базу данных.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+func (s *Store) queryTrainings(query string, args ...any) ([]spentcalories.TrainingEntry, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить тренировки: %w", err)
+	}
+	defer rows.Close()
+
+	var result []spentcalories.TrainingEntry
+	for rows.Next() {
+		var (
+			entry      spentcalories.TrainingEntry
+			date       string
+			durationNs int64
+		)
+		if err := rows.Scan(&date, &entry.Steps, &entry.Activity, &durationNs, &entry.Distance, &entry.Calories); err != nil {
+			return nil, fmt.Errorf("не удалось прочитать тренировку: %w", err)
+		}
+
+		entry.Date, err = parseDate(date)
+		if err != nil {
+			return nil, err
+		}
+		entry.Duration = time.Duration(durationNs)
+
+		result = append(result, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("не удалось получить тренировки: %w", err)
+	}
+
+	return result, nil
+}
+
+func (s *Store) queryDays(from, to time.Time) ([]daysteps.DayEntry, error) {
+	rows, err := s.db.Query(
+		`SELECT date, steps, duration_ns FROM day_packages
+		WHERE date_ns >= ? AND date_ns < ? ORDER BY date_ns, id`,
+		from.UnixNano(), to.UnixNano(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить дневную активность: %w", err)
+	}
+	defer rows.Close()
+
+	var result []daysteps.DayEntry
+	for rows.Next() {
+		var (
+			entry      daysteps.DayEntry
+			date       string
+			durationNs int64
+		)
+		if err := rows.Scan(&date, &entry.Steps, &durationNs); err != nil {
+			return nil, fmt.Errorf("не удалось прочитать дневную активность: %w", err)
+		}
+
+		entry.Date, err = parseDate(date)
+		if err != nil {
+			return nil, err
+		}
+		entry.Duration = time.Duration(durationNs)
+
+		result = append(result, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("не удалось получить дневную активность: %w", err)
+	}
+
+	return result, nil
+}
+
+// activityKey приводит вид активности к ключу индекса. Регистр приводится в Go,
+// потому что встроенная функция lower() в SQLite не обрабатывает кириллицу.
+func activityKey(activity string) string {
+	return strings.ToLower(strings.TrimSpace(activity))
+}
+
+func formatDate(t time.Time) string {
+	return t.Format(time.RFC3339Nano)
+}
+
+func parseDate(s string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("неверный формат даты в базе данных: %w", err)
+	}
+	return t, nil
+}
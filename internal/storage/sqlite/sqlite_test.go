@@ -0,0 +1,82 @@
+package sqlite
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	_ "modernc.org/sqlite"
+
+	"github.com/Yandex-Practicum/tracker/internal/daysteps"
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+type SQLiteTestSuite struct {
+	suite.Suite
+}
+
+func TestSQLiteSuite(t *testing.T) {
+	suite.Run(t, new(SQLiteTestSuite))
+}
+
+// open открывает базу через драйвер modernc.org/sqlite на чистом Go. Сам пакет драйвер
+// не импортирует, его выбирает вызывающий код; тестам он нужен только для проверки.
+func (suite *SQLiteTestSuite) open(path string) *Store {
+	db, err := sql.Open("sqlite", path)
+	suite.Require().NoError(err)
+
+	store, err := New(db)
+	suite.Require().NoError(err)
+	return store
+}
+
+func day(d, hour int) time.Time {
+	return time.Date(2025, time.January, d, hour, 0, 0, 0, time.UTC)
+}
+
+func (suite *SQLiteTestSuite) TestRoundTrip() {
+	path := filepath.Join(suite.T().TempDir(), "tracker.db")
+	store := suite.open(path)
+
+	run, err := spentcalories.NewTrainingEntry(day(14, 18), "6000,Бег,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	walk, err := spentcalories.NewTrainingEntry(day(13, 18), "3456,Ходьба,3h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	assert.NoError(suite.T(), store.SaveTraining(run))
+	assert.NoError(suite.T(), store.SaveTraining(walk))
+	assert.NoError(suite.T(), store.SaveDayPackage(daysteps.DayEntry{Date: day(13, 8), Steps: 6000, Duration: time.Hour}))
+	assert.NoError(suite.T(), store.SaveDayPackage(daysteps.DayEntry{Date: day(20, 8), Steps: 3000, Duration: 30 * time.Minute}))
+	assert.NoError(suite.T(), store.Close())
+
+	// Схема создаётся повторно без ошибок, данные сохраняются
+	reopened := suite.open(path)
+	defer reopened.Close()
+
+	records, err := reopened.ListByDate(day(13, 0), day(15, 0))
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), records.Trainings, 2)
+	assert.Equal(suite.T(), "Ходьба", records.Trainings[0].Activity)
+	assert.Equal(suite.T(), "Бег", records.Trainings[1].Activity)
+	assert.Equal(suite.T(), run.Calories, records.Trainings[1].Calories)
+	assert.Equal(suite.T(), run.Duration, records.Trainings[1].Duration)
+	assert.Len(suite.T(), records.Days, 1)
+	assert.Equal(suite.T(), 6000, records.Days[0].Steps)
+	assert.Equal(suite.T(), time.Hour, records.Days[0].Duration)
+
+	runs, err := reopened.ListByActivity(" бег ")
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), runs, 1)
+	assert.True(suite.T(), run.Date.Equal(runs[0].Date))
+
+	none, err := reopened.ListByActivity("Велосипед")
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), none)
+}
+
+func (suite *SQLiteTestSuite) TestActivityKey() {
+	assert.Equal(suite.T(), "бег", activityKey(" БЕГ "))
+}
@@ -0,0 +1,30 @@
+// Package storage сохраняет записи тренировок и дневной активности между перезапусками.
+package storage
+
+import (
+	"time"
+
+	"github.com/Yandex-Practicum/tracker/internal/daysteps"
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+// Records — записи тренировок и дневной активности, упорядоченные по дате.
+type Records struct {
+	Trainings []spentcalories.TrainingEntry
+	Days      []daysteps.DayEntry
+}
+
+// Store — хранилище записей тренировок и дневной активности.
+type Store interface {
+	// SaveTraining сохраняет тренировку.
+	SaveTraining(entry spentcalories.TrainingEntry) error
+	// SaveDayPackage сохраняет запись дневной активности.
+	SaveDayPackage(entry daysteps.DayEntry) error
+	// ListByDate возвращает записи с датой в интервале [from, to), упорядоченные по дате.
+	ListByDate(from, to time.Time) (Records, error)
+	// ListByActivity возвращает тренировки с заданным видом активности, упорядоченные по дате.
+	// Вид активности сравнивается без учета регистра и пробелов по краям.
+	ListByActivity(activity string) ([]spentcalories.TrainingEntry, error)
+	// Close освобождает ресурсы хранилища.
+	Close() error
+}
@@ -0,0 +1,128 @@
+// Package tracker накапливает итоги текущего дня по записям, поступающим
+// одновременно из нескольких источников, например от воркеров синхронизации устройств.
+//
+// Записи суммируются без пересчета, поэтому дневная запись "шаги,длительность" должна
+// содержать только шаги вне тренировок. Если шагомер считает все шаги за день вместе
+// с тренировками, рассчитывайте день через daysteps.CombinedDay, иначе шаги
+// тренировок будут учтены дважды.
+package tracker
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Yandex-Practicum/tracker/internal/daysteps"
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+// Snapshot — итоги дня на момент вызова Tracker.Snapshot.
+type Snapshot struct {
+	Date time.Time // начало дня по местному времени.
+	daysteps.DayTotal
+}
+
+// Tracker суммирует дневную активность и тренировки за текущий день.
+// При переходе на новый день итоги обнуляются. Методы безопасно вызывать
+// одновременно из нескольких горутин. Tracker нельзя копировать.
+type Tracker struct {
+	weight float64
+	height float64
+	now    func() time.Time
+
+	mu    sync.Mutex
+	day   time.Time
+	total daysteps.DayTotal
+}
+
+// New создаёт Tracker для пользователя с заданным весом в килограммах и ростом в метрах.
+func New(weight, height float64) (*Tracker, error) {
+	if weight <= 0 {
+		return nil, errors.New("вес должен быть больше 0")
+	}
+	if err := spentcalories.ValidateHeight(height); err != nil {
+		return nil, err
+	}
+
+	return &Tracker{weight: weight, height: height, now: time.Now}, nil
+}
+
+// Add разбирает запись и добавляет её к итогам текущего дня. Запись из двух полей
+// "шаги,длительность" считается дневной активностью, остальные — тренировкой
+// в формате spentcalories.NewTraining. Дневная запись не должна включать шаги
+// тренировок, добавленных отдельно: Add не вычитает их, а суммирует.
+// Некорректная запись не меняет итоги.
+func (t *Tracker) Add(record string) error {
+	// Расчет не требует блокировки, поэтому выполняется до неё
+	total, err := t.calculate(record)
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.rollover()
+	t.total.Records++
+	t.total.Steps += total.Steps
+	t.total.Duration += total.Duration
+	t.total.Distance += total.Distance
+	t.total.Calories += total.Calories
+
+	return nil
+}
+
+// Snapshot возвращает согласованный снимок итогов текущего дня.
+func (t *Tracker) Snapshot() Snapshot {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.rollover()
+	return Snapshot{Date: t.day, DayTotal: t.total}
+}
+
+// calculate рассчитывает показатели одной записи.
+// Обороты педалей и круги плавания не учитываются как шаги.
+func (t *Tracker) calculate(record string) (daysteps.DayTotal, error) {
+	if strings.Count(record, ",") == 1 {
+		summary, err := daysteps.DayActionInfoE(record, t.weight, t.height)
+		if err != nil {
+			return daysteps.DayTotal{}, err
+		}
+
+		return daysteps.DayTotal{
+			Steps:    summary.Steps,
+			Duration: summary.Duration,
+			Distance: summary.Distance,
+			Calories: summary.Calories,
+		}, nil
+	}
+
+	training, err := spentcalories.NewTraining(record, t.weight, t.height)
+	if err != nil {
+		return daysteps.DayTotal{}, err
+	}
+
+	total := daysteps.DayTotal{
+		Duration: training.Duration,
+		Distance: training.Distance,
+		Calories: training.Calories,
+	}
+	if spentcalories.IsStepActivity(training.Activity) {
+		total.Steps = training.Steps
+	}
+
+	return total, nil
+}
+
+// rollover обнуляет итоги, если наступил новый день. Вызывается под блокировкой.
+func (t *Tracker) rollover() {
+	now := t.now()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	if !day.Equal(t.day) {
+		t.day = day
+		t.total = daysteps.DayTotal{}
+	}
+}
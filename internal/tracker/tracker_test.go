@@ -0,0 +1,107 @@
+package tracker
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type TrackerTestSuite struct {
+	suite.Suite
+	tracker *Tracker
+	now     time.Time
+}
+
+func TestTrackerSuite(t *testing.T) {
+	suite.Run(t, new(TrackerTestSuite))
+}
+
+func (suite *TrackerTestSuite) SetupTest() {
+	tr, err := New(75.0, 1.75)
+	suite.Require().NoError(err)
+
+	suite.now = time.Date(2025, time.January, 14, 12, 0, 0, 0, time.UTC)
+	tr.now = func() time.Time { return suite.now }
+	suite.tracker = tr
+}
+
+func (suite *TrackerTestSuite) TestNew() {
+	_, err := New(0, 1.75)
+	assert.Error(suite.T(), err)
+
+	_, err = New(75.0, 0)
+	assert.Error(suite.T(), err)
+
+	// Рост в сантиметрах отклоняется так же, как в расчетах тренировок
+	_, err = New(75.0, 175)
+	assert.ErrorContains(suite.T(), err, "сантиметрах")
+}
+
+func (suite *TrackerTestSuite) TestAdd() {
+	assert.NoError(suite.T(), suite.tracker.Add("6000,1h00m"))
+	assert.NoError(suite.T(), suite.tracker.Add("6000,Бег,1h00m"))
+	assert.NoError(suite.T(), suite.tracker.Add("1500,Велосипед,20m"))
+
+	assert.Error(suite.T(), suite.tracker.Add("6000,Плавание,1h00m"))
+	assert.Error(suite.T(), suite.tracker.Add("abc,1h00m"))
+
+	got := suite.tracker.Snapshot()
+	assert.Equal(suite.T(), time.Date(2025, time.January, 14, 0, 0, 0, 0, time.UTC), got.Date)
+	assert.Equal(suite.T(), 3, got.Records)
+
+	// Обороты педалей не считаются шагами
+	assert.Equal(suite.T(), 12000, got.Steps)
+	assert.Equal(suite.T(), 2*time.Hour+20*time.Minute, got.Duration)
+	assert.InDelta(suite.T(), 3.9+4.725+9, got.Distance, 1e-9)
+	assert.InDelta(suite.T(), 177.1875+354.375+300, got.Calories, 1e-9)
+}
+
+func (suite *TrackerTestSuite) TestRollover() {
+	assert.NoError(suite.T(), suite.tracker.Add("6000,1h00m"))
+
+	// В новый день итоги начинаются с нуля
+	suite.now = suite.now.Add(12 * time.Hour)
+	got := suite.tracker.Snapshot()
+	assert.Equal(suite.T(), time.Date(2025, time.January, 15, 0, 0, 0, 0, time.UTC), got.Date)
+	assert.Zero(suite.T(), got.Records)
+	assert.Zero(suite.T(), got.Steps)
+
+	assert.NoError(suite.T(), suite.tracker.Add("3000,30m"))
+	assert.Equal(suite.T(), 3000, suite.tracker.Snapshot().Steps)
+}
+
+func (suite *TrackerTestSuite) TestConcurrentAdd() {
+	const (
+		workers = 8
+		records = 50
+	)
+
+	var wg sync.WaitGroup
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range records {
+				assert.NoError(suite.T(), suite.tracker.Add(fmt.Sprintf("%d,1m", 100+w+i%2)))
+				_ = suite.tracker.Snapshot()
+			}
+		}()
+	}
+	wg.Wait()
+
+	got := suite.tracker.Snapshot()
+	assert.Equal(suite.T(), workers*records, got.Records)
+
+	want := 0
+	for w := range workers {
+		for i := range records {
+			want += 100 + w + i%2
+		}
+	}
+	assert.Equal(suite.T(), want, got.Steps)
+	assert.Equal(suite.T(), time.Duration(workers*records)*time.Minute, got.Duration)
+}
@@ -0,0 +1,95 @@
+// Package units переводит значения между метрической и имперской системами измерения
+// и хранит обозначения единиц для отчётов. Используется пакетами spentcalories и daysteps.
+package units
+
+import "fmt"
+
+// Коэффициенты перевода имперских единиц в метрические.
+const (
+	KgInLb   = 0.45359237 // количество килограммов в фунте.
+	MInIn    = 0.0254     // количество метров в дюйме.
+	KmInMile = 1.609344   // количество километров в миле.
+)
+
+// System — система измерения для ввода веса и роста и вывода дистанции и скорости.
+type System string
+
+// Поддерживаемые системы измерения.
+const (
+	Metric   System = "metric"   // килограммы, метры, километры; используется по умолчанию.
+	Imperial System = "imperial" // фунты, дюймы, мили.
+)
+
+// Validate проверяет, что система измерения поддерживается. Пустое значение означает Metric.
+func (s System) Validate() error {
+	switch s {
+	case "", Metric, Imperial:
+		return nil
+	default:
+		return fmt.Errorf("неизвестная система измерения: %q", s)
+	}
+}
+
+// WeightToKg переводит вес в этой системе измерения в килограммы.
+func (s System) WeightToKg(weight float64) float64 {
+	if s == Imperial {
+		return weight * KgInLb
+	}
+	return weight
+}
+
+// HeightToMeters переводит рост в этой системе измерения в метры.
+func (s System) HeightToMeters(height float64) float64 {
+	if s == Imperial {
+		return height * MInIn
+	}
+	return height
+}
+
+// FromKm переводит дистанцию в километрах или скорость в км/ч в единицы этой системы.
+func (s System) FromKm(km float64) float64 {
+	if s == Imperial {
+		return km / KmInMile
+	}
+	return km
+}
+
+// labels — обозначения единиц дистанции и скорости.
+type labels struct {
+	distance string
+	speed    string
+}
+
+// unitLabels — обозначения единиц по языку отчёта ("ru", "en") и системе измерения.
+var unitLabels = map[string]map[System]labels{
+	"ru": {
+		Metric:   {distance: "км", speed: "км/ч"},
+		Imperial: {distance: "миль", speed: "миль/ч"},
+	},
+	"en": {
+		Metric:   {distance: "km", speed: "km/h"},
+		Imperial: {distance: "mi", speed: "mph"},
+	},
+}
+
+func (s System) labels(lang string) labels {
+	if s == "" {
+		s = Metric
+	}
+
+	byLang, ok := unitLabels[lang]
+	if !ok {
+		byLang = unitLabels["ru"]
+	}
+	return byLang[s]
+}
+
+// DistanceLabel возвращает обозначение единицы дистанции на языке lang ("ru" или "en").
+func (s System) DistanceLabel(lang string) string {
+	return s.labels(lang).distance
+}
+
+// SpeedLabel возвращает обозначение единицы скорости на языке lang ("ru" или "en").
+func (s System) SpeedLabel(lang string) string {
+	return s.labels(lang).speed
+}
@@ -0,0 +1,86 @@
+package units
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type UnitsTestSuite struct {
+	suite.Suite
+}
+
+func TestUnitsSuite(t *testing.T) {
+	suite.Run(t, new(UnitsTestSuite))
+}
+
+func (suite *UnitsTestSuite) TestValidate() {
+	assert.NoError(suite.T(), System("").Validate())
+	assert.NoError(suite.T(), Metric.Validate())
+	assert.NoError(suite.T(), Imperial.Validate())
+	assert.Error(suite.T(), System("nautical").Validate())
+}
+
+func (suite *UnitsTestSuite) TestConversions() {
+	tests := []struct {
+		name       string
+		system     System
+		weight     float64
+		height     float64
+		km         float64
+		wantWeight float64
+		wantHeight float64
+		wantDist   float64
+	}{
+		{
+			name:       "метрическая система",
+			system:     Metric,
+			weight:     75,
+			height:     1.75,
+			km:         10,
+			wantWeight: 75,
+			wantHeight: 1.75,
+			wantDist:   10,
+		},
+		{
+			name:       "система не указана",
+			system:     "",
+			weight:     75,
+			height:     1.75,
+			km:         10,
+			wantWeight: 75,
+			wantHeight: 1.75,
+			wantDist:   10,
+		},
+		{
+			name:       "имперская система",
+			system:     Imperial,
+			weight:     165,
+			height:     69,
+			km:         KmInMile * 3,
+			wantWeight: 165 * KgInLb,
+			wantHeight: 1.7526,
+			wantDist:   3,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.InDelta(suite.T(), tt.wantWeight, tt.system.WeightToKg(tt.weight), 1e-9)
+			assert.InDelta(suite.T(), tt.wantHeight, tt.system.HeightToMeters(tt.height), 1e-9)
+			assert.InDelta(suite.T(), tt.wantDist, tt.system.FromKm(tt.km), 1e-9)
+		})
+	}
+}
+
+func (suite *UnitsTestSuite) TestLabels() {
+	assert.Equal(suite.T(), "км", System("").DistanceLabel("ru"))
+	assert.Equal(suite.T(), "км/ч", Metric.SpeedLabel("ru"))
+	assert.Equal(suite.T(), "миль", Imperial.DistanceLabel("ru"))
+	assert.Equal(suite.T(), "mph", Imperial.SpeedLabel("en"))
+	assert.Equal(suite.T(), "km", Metric.DistanceLabel("en"))
+
+	// Для неизвестного языка используются русские обозначения
+	assert.Equal(suite.T(), "миль/ч", Imperial.SpeedLabel("de"))
+}